@@ -2,7 +2,9 @@ data "gdashboard_bar_gauge" "jobs_processed" {
   title = "Jobs Processed"
 
   field {
-    decimals = 0
+    decimals     = 0
+    display_name = "$${__field.labels.job_type}"
+    description  = "The number of jobs per type"
   }
 
   overrides {
@@ -18,8 +20,15 @@ data "gdashboard_bar_gauge" "jobs_processed" {
   }
 
   graph {
-    orientation  = "horizontal"
-    display_mode = "basic"
+    orientation    = "horizontal"
+    display_mode   = "basic"
+    value_mode     = "color"
+    name_placement = "top"
+    show_unfilled  = true
+    sizing         = "manual"
+    min_viz_width  = 8
+    min_viz_height = 16
+    max_viz_height = 300
 
     options {
       calculation = "lastNotNull"
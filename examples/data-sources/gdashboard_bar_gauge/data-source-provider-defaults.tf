@@ -6,8 +6,9 @@ provider "gdashboard" {
       }
 
       graph {
-        orientation  = "horizontal"
-        display_mode = "basic"
+        orientation    = "horizontal"
+        display_mode   = "basic"
+        name_placement = "left"
 
         options {
           calculation = "lastNotNull"
@@ -3,7 +3,7 @@ data "gdashboard_timeseries" "jvm_memory" {
 
   queries {
     prometheus {
-      uid  = "prometheus"
+      uid  = "$region"
       expr = "sum(increase(jvm_memory_total{container_name='container'}[$__rate_interval]))"
     }
   }
@@ -19,6 +19,37 @@ data "gdashboard_dashboard" "jvm_dashboard" {
     to   = "now+1h"
   }
 
+  links {
+    dashboards {
+      title             = "JVM"
+      tags              = ["jvm"]
+      as_dropdown       = true
+      include_variables = true
+    }
+
+    link {
+      title        = "Runbook"
+      url          = "https://runbooks.example.com/jvm"
+      icon         = "doc"
+      target_blank = true
+    }
+  }
+
+  annotations {
+    prometheus {
+      name           = "Restarts"
+      datasource_uid = "$region"
+      expr           = "changes(process_start_time_seconds{container_name='container'}[5m]) > 0"
+      title_format   = "{{instance}} restarted"
+    }
+
+    grafana {
+      name  = "Deployments"
+      tags  = ["deployment", "jvm"]
+      color = "blue"
+    }
+  }
+
   variables {
     const {
       name  = "var"
@@ -39,6 +70,57 @@ data "gdashboard_dashboard" "jvm_dashboard" {
         selected = true
       }
     }
+
+    custom {
+      name        = "env"
+      label       = "Environment"
+      multi       = true
+      include_all = true
+
+      option {
+        text     = "Production"
+        value    = "prod"
+        selected = true
+      }
+
+      option {
+        text  = "Staging"
+        value = "staging"
+      }
+    }
+
+    query {
+      name           = "instance"
+      datasource_uid = "prometheus"
+      query          = "label_values(jvm_memory_total{container_name='container'}, instance)"
+      sort           = "alphabetical-asc"
+      refresh        = "on-time-range-change"
+      multi          = true
+      include_all    = true
+      all_value      = ".*"
+    }
+
+    datasource {
+      name  = "region"
+      type  = "prometheus"
+      regex = "/prometheus-.*/"
+    }
+
+    interval {
+      name      = "step"
+      intervals = ["1m", "10m", "1h"]
+      auto      = true
+    }
+
+    textbox {
+      name          = "tenant"
+      default_value = "acme"
+    }
+
+    adhoc {
+      name           = "filters"
+      datasource_uid = "$region"
+    }
   }
 
   layout {
@@ -39,6 +39,9 @@ data "gdashboard_gauge" "jvm_memory" {
     orientation            = "horizontal"
     show_threshold_labels  = true
     show_threshold_markers = true
+    sizing                 = "manual"
+    min_viz_width          = 75
+    min_viz_height         = 75
 
     options {
       calculation = "lastNotNull"
@@ -55,4 +58,11 @@ data "gdashboard_gauge" "jvm_memory" {
       instant       = true
     }
   }
+
+  links {
+    title              = "Runbook"
+    url                = "https://runbooks.example.com/jvm-memory"
+    target_blank       = true
+    include_time_range = true
+  }
 }
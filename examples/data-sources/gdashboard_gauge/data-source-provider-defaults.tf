@@ -27,6 +27,7 @@ provider "gdashboard" {
         orientation            = "horizontal"
         show_threshold_labels  = true
         show_threshold_markers = true
+        sizing                 = "auto"
 
         options {
           calculation = "lastNotNull"
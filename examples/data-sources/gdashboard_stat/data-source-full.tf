@@ -39,10 +39,12 @@ data "gdashboard_stat" "status" {
   }
 
   graph {
-    orientation = "vertical"
-    text_mode   = "value"
-    color_mode  = "background"
-    graph_mode  = "none"
+    orientation               = "vertical"
+    text_mode                 = "value"
+    color_mode                = "background"
+    graph_mode                = "none"
+    show_percent_change       = true
+    percent_change_color_mode = "inverted"
 
     options {
       values      = true
@@ -64,4 +66,19 @@ data "gdashboard_stat" "status" {
       instant = true
     }
   }
+
+  transformations {
+    labels_to_fields {
+      value_label = "instance"
+    }
+  }
+
+  transformations {
+    organize {
+      exclude = ["Time"]
+      rename = {
+        Value = "Status"
+      }
+    }
+  }
 }
@@ -5,10 +5,13 @@ data "gdashboard_timeseries" "jvm_memory" {
     calculations = ["min", "max", "mean"]
     display_mode = "table"
     placement    = "bottom"
+    sort_by      = "Max"
+    sort_desc    = true
   }
 
   tooltip {
     mode = "multi"
+    sort = "desc"
   }
 
   field {
@@ -22,6 +25,12 @@ data "gdashboard_timeseries" "jvm_memory" {
       fixed_color = "green"
       series_by   = "last"
     }
+
+    link {
+      title        = "Container dashboard"
+      url          = "/d/container?var-container=$${__field.labels.container_name}"
+      target_blank = true
+    }
   }
 
   overrides {
@@ -34,12 +43,40 @@ data "gdashboard_timeseries" "jvm_memory" {
         }
       }
     }
+
+    by_query_id {
+      query_id = "Loki_Query"
+      field {
+        axis {
+          label     = "Errors"
+          placement = "right"
+        }
+
+        graph {
+          draw_style       = "bars"
+          bar_alignment    = "after"
+          bar_width_factor = 0.75
+          transform        = "negative-Y"
+        }
+
+        hide_from {
+          tooltip = true
+        }
+      }
+    }
   }
 
   graph {
-    fill_opacity = 10
-    show_points  = "always"
-    span_nulls   = true
+    fill_opacity     = 10
+    show_points      = "always"
+    span_nulls       = true
+    thresholds_style = "dashed"
+  }
+
+  query_options {
+    max_data_points = 500
+    min_interval    = "1m"
+    relative_time   = "now-5m"
   }
 
   queries {
@@ -51,6 +88,17 @@ data "gdashboard_timeseries" "jvm_memory" {
       min_interval  = "30"
       legend_format = "Memory total"
     }
+
+    raw {
+      datasource_uid  = "loki"
+      datasource_type = "loki"
+      ref_id          = "Loki_Query"
+      model = jsonencode({
+        expr         = "sum(count_over_time({container_name=\"container\"} |= \"OutOfMemoryError\" [5m]))"
+        queryType    = "range"
+        legendFormat = "OOM errors"
+      })
+    }
   }
 
 }
@@ -0,0 +1,48 @@
+data "gdashboard_variable" "namespace" {
+  query {
+    name           = "namespace"
+    datasource_uid = "prometheus"
+    query          = "label_values(kube_pod_info{cluster=\"$cluster\"}, namespace)"
+    refresh        = "on-time-range-change"
+    multi          = true
+    include_all    = true
+  }
+}
+
+data "gdashboard_timeseries" "pods" {
+  title = "Pods"
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "sum by (pod) (kube_pod_info{cluster=\"$cluster\", namespace=~\"$namespace\"})"
+    }
+  }
+}
+
+data "gdashboard_dashboard" "pods" {
+  title = "Pods"
+
+  variables {
+    const {
+      name  = "cluster"
+      value = "eu-west-1"
+    }
+  }
+
+  variables {
+    source = data.gdashboard_variable.namespace.json
+  }
+
+  layout {
+    row {
+      panel {
+        size = {
+          height = 8
+          width  = 10
+        }
+        source = data.gdashboard_timeseries.pods.json
+      }
+    }
+  }
+}
@@ -9,6 +9,14 @@ provider "gdashboard" {
         from = "now-12h"
         to   = "now-3h"
       }
+
+      annotations {
+        grafana {
+          name  = "Deployments"
+          tags  = ["deployment"]
+          color = "blue"
+        }
+      }
     }
 
     stat {
@@ -87,6 +95,22 @@ provider "gdashboard" {
         show_points        = "never"
         point_size         = 22
         stack_series       = "percent"
+        thresholds_style   = "line"
+      }
+    }
+
+    queries {
+      prometheus {
+        exemplar    = true
+        editor_mode = "code"
+      }
+    }
+
+    panel {
+      links {
+        title        = "On-call handbook"
+        url          = "https://wiki.example.com/on-call"
+        target_blank = true
       }
     }
   }
@@ -8,15 +8,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/iRevive/terraform-provider-gdashboard/internal/provider/grafana"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ datasource.DataSource = &BarGaugeDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &BarGaugeDataSource{}
 
 func NewBarGaugeDataSource() datasource.DataSource {
 	return &BarGaugeDataSource{}
@@ -28,34 +31,54 @@ type BarGaugeDataSource struct {
 }
 
 type BarGaugeDefaults struct {
-	Field FieldDefaults
-	Graph BarGaugeGraphDefault
+	Field        FieldDefaults
+	Graph        BarGaugeGraphDefault
+	QueryOptions QueryOptionsDefaults
+	Queries      QueryDefaults
+	Panel        PanelDefaults
 }
 
 type BarGaugeGraphDefault struct {
 	Orientation   string
 	DisplayMode   string
 	TextAlignment string
+	ValueMode     string
+	NamePlacement string
+	ShowUnfilled  bool
+	Sizing        string
+	MinVizWidth   int
+	MinVizHeight  int
+	MaxVizHeight  int
 	TextSize      TextSizeDefaults
 	ReduceOptions ReduceOptionDefaults
 }
 
 // BarGaugeDataSourceModel describes the data source data model.
 type BarGaugeDataSourceModel struct {
-	Id          types.String           `tfsdk:"id"`
-	Json        types.String           `tfsdk:"json"`
-	Title       types.String           `tfsdk:"title"`
-	Description types.String           `tfsdk:"description"`
-	Queries     []Query                `tfsdk:"queries"`
-	Field       []FieldOptions         `tfsdk:"field"`
-	Graph       []BarGaugeOptions      `tfsdk:"graph"`
-	Overrides   []FieldOverrideOptions `tfsdk:"overrides"`
+	Id              types.String                         `tfsdk:"id"`
+	Json            types.String                         `tfsdk:"json"`
+	Title           types.String                         `tfsdk:"title"`
+	Description     types.String                         `tfsdk:"description"`
+	Queries         []Query                              `tfsdk:"queries"`
+	Field           []FieldOptions                       `tfsdk:"field"`
+	Graph           []BarGaugeOptions                    `tfsdk:"graph"`
+	Overrides       []FieldOverrideOptions[FieldOptions] `tfsdk:"overrides"`
+	QueryOptions    []QueryOptions                       `tfsdk:"query_options"`
+	Transformations []Transformations                    `tfsdk:"transformations"`
+	Links           []PanelLinkOptions                   `tfsdk:"links"`
 }
 
 type BarGaugeOptions struct {
 	Orientation   types.String      `tfsdk:"orientation"`
 	DisplayMode   types.String      `tfsdk:"display_mode"`
 	TextAlignment types.String      `tfsdk:"text_alignment"`
+	ValueMode     types.String      `tfsdk:"value_mode"`
+	NamePlacement types.String      `tfsdk:"name_placement"`
+	ShowUnfilled  types.Bool        `tfsdk:"show_unfilled"`
+	Sizing        types.String      `tfsdk:"sizing"`
+	MinVizWidth   types.Int64       `tfsdk:"min_viz_width"`
+	MinVizHeight  types.Int64       `tfsdk:"min_viz_height"`
+	MaxVizHeight  types.Int64       `tfsdk:"max_viz_height"`
 	TextSize      []TextSizeOptions `tfsdk:"text_size"`
 	ReduceOptions []ReduceOptions   `tfsdk:"options"`
 }
@@ -97,6 +120,61 @@ func barGaugeGraphBlock() schema.Block {
 						stringvalidator.OneOf("auto", "center"),
 					},
 				},
+				"value_mode": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The display of the value. The choices are: color, text, hidden.",
+					MarkdownDescription: "The display of the value. The choices are: `color`, `text`, `hidden`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("color", "text", "hidden"),
+					},
+				},
+				"name_placement": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The placement of the name. The choices are: auto, top, left, hidden.",
+					MarkdownDescription: "The placement of the name. The choices are: `auto`, `top`, `left`, `hidden`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("auto", "top", "left", "hidden"),
+					},
+				},
+				"show_unfilled": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to render the unfilled region of the bar as gray or not.",
+				},
+				"sizing": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The sizing of the bars. The min and max sizes apply to the manual sizing only. The choices are: auto, manual.",
+					MarkdownDescription: "The sizing of the bars. The min and max sizes apply to the `manual` sizing only. The choices are: `auto`, `manual`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("auto", "manual"),
+					},
+				},
+				"min_viz_width": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The minimum width of the bars in pixels. Must be between 0 and 600 (inclusive).",
+					MarkdownDescription: "The minimum width of the bars in pixels. Must be between `0` and `600` (inclusive).",
+					Validators: []validator.Int64{
+						int64validator.Between(0, 600),
+					},
+				},
+				"min_viz_height": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The minimum height of the bars in pixels. Must be between 0 and 600 (inclusive).",
+					MarkdownDescription: "The minimum height of the bars in pixels. Must be between `0` and `600` (inclusive).",
+					Validators: []validator.Int64{
+						int64validator.Between(0, 600),
+					},
+				},
+				"max_viz_height": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The maximum height of the bars in pixels. Must be between 0 and 600 (inclusive).",
+					MarkdownDescription: "The maximum height of the bars in pixels. Must be between `0` and `600` (inclusive).",
+					Validators: []validator.Int64{
+						int64validator.Between(0, 600),
+					},
+				},
+			},
+			Validators: []validator.Object{
+				lessThanOrEqual("min_viz_height", "max_viz_height"),
 			},
 		},
 		Validators: []validator.List{
@@ -112,10 +190,13 @@ func (d *BarGaugeDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 		MarkdownDescription: "Bar gauge panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/bar-gauge/) for more details.",
 
 		Blocks: map[string]schema.Block{
-			"queries":   queryBlock(),
-			"field":     fieldBlock(),
-			"graph":     barGaugeGraphBlock(),
-			"overrides": fieldOverrideBlock(),
+			"queries":         queryBlock(),
+			"field":           fieldBlock(),
+			"graph":           barGaugeGraphBlock(),
+			"overrides":       fieldOverrideBlock(fieldBlock()),
+			"query_options":   queryOptionsBlock(),
+			"transformations": transformationsBlock(),
+			"links":           panelLinksBlock(),
 		},
 
 		Attributes: map[string]schema.Attribute{
@@ -145,6 +226,16 @@ func (d *BarGaugeDataSource) Configure(_ context.Context, req datasource.Configu
 	d.Defaults = defaults.BarGauge
 }
 
+func (d *BarGaugeDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data BarGaugeDataSourceModel
+
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		return
+	}
+
+	validatePanelConfig(data.Queries, data.Overrides, createOverrideProperties, &resp.Diagnostics)
+}
+
 func (d *BarGaugeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data BarGaugeDataSourceModel
 
@@ -155,13 +246,30 @@ func (d *BarGaugeDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	targets := createTargets(data.Queries)
+	targets := createTargets(d.Defaults.Queries, data.Queries, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	fieldConfig := createFieldConfig(d.Defaults.Field, data.Field)
 
+	// the options keep pointers, hence the defaults are copied so the panels never share them
+	showUnfilled := d.Defaults.Graph.ShowUnfilled
+	minVizWidth := d.Defaults.Graph.MinVizWidth
+	minVizHeight := d.Defaults.Graph.MinVizHeight
+	maxVizHeight := d.Defaults.Graph.MaxVizHeight
+
 	options := grafana.Options{
 		Orientation: d.Defaults.Graph.Orientation,
 		DisplayMode: d.Defaults.Graph.DisplayMode,
 		JustifyMode: d.Defaults.Graph.TextAlignment,
+		// bar gauge specific
+		ValueMode:     d.Defaults.Graph.ValueMode,
+		NamePlacement: d.Defaults.Graph.NamePlacement,
+		ShowUnfilled:  &showUnfilled,
+		Sizing:        d.Defaults.Graph.Sizing,
+		MinVizWidth:   &minVizWidth,
+		MinVizHeight:  &minVizHeight,
+		MaxVizHeight:  &maxVizHeight,
 		ReduceOptions: grafana.ReduceOptions{
 			Values: d.Defaults.Graph.ReduceOptions.Values,
 			Fields: d.Defaults.Graph.ReduceOptions.Fields,
@@ -187,10 +295,53 @@ func (d *BarGaugeDataSource) Read(ctx context.Context, req datasource.ReadReques
 			options.JustifyMode = graph.TextAlignment.ValueString()
 		}
 
+		if !graph.ValueMode.IsNull() {
+			options.ValueMode = graph.ValueMode.ValueString()
+		}
+
+		if !graph.NamePlacement.IsNull() {
+			options.NamePlacement = graph.NamePlacement.ValueString()
+		}
+
+		if !graph.ShowUnfilled.IsNull() {
+			show := graph.ShowUnfilled.ValueBool()
+			options.ShowUnfilled = &show
+		}
+
+		if !graph.Sizing.IsNull() {
+			options.Sizing = graph.Sizing.ValueString()
+		}
+
+		if !graph.MinVizWidth.IsNull() {
+			width := int(graph.MinVizWidth.ValueInt64())
+			options.MinVizWidth = &width
+		}
+
+		if !graph.MinVizHeight.IsNull() {
+			height := int(graph.MinVizHeight.ValueInt64())
+			options.MinVizHeight = &height
+		}
+
+		if !graph.MaxVizHeight.IsNull() {
+			height := int(graph.MaxVizHeight.ValueInt64())
+			options.MaxVizHeight = &height
+		}
+
 		updateTextSize(&options.TextSize, graph.TextSize)
 		updateReduceOptions(&options.ReduceOptions, graph.ReduceOptions)
 	}
 
+	// the attributes are validated by the schema, the values combined with the provider defaults are validated here
+	if *options.MinVizHeight > *options.MaxVizHeight {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("graph"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Attribute min_viz_height must be less than or equal to max_viz_height, including the provider defaults, got: min_viz_height = %d, max_viz_height = %d",
+				*options.MinVizHeight, *options.MaxVizHeight),
+		)
+		return
+	}
+
 	panel := &grafana.Panel{
 		CommonPanel: grafana.CommonPanel{
 			OfType: grafana.BarGaugeType,
@@ -204,7 +355,7 @@ func (d *BarGaugeDataSource) Read(ctx context.Context, req datasource.ReadReques
 			Options: options,
 			FieldConfig: grafana.FieldConfig{
 				Defaults:  fieldConfig,
-				Overrides: createOverrides(data.Overrides),
+				Overrides: createOverrides(data.Overrides, createOverrideProperties),
 			},
 		},
 	}
@@ -214,6 +365,15 @@ func (d *BarGaugeDataSource) Read(ctx context.Context, req datasource.ReadReques
 		panel.CommonPanel.Description = &description
 	}
 
+	updateQueryOptions(&panel.CommonPanel, d.Defaults.QueryOptions, data.QueryOptions)
+
+	panel.CommonPanel.Transformations = createTransformations(data.Transformations, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	panel.CommonPanel.Links = createPanelLinks(d.Defaults.Panel, data.Links)
+
 	jsonData, err := json.MarshalIndent(panel, "", "  ")
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
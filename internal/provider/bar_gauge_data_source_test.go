@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -33,6 +34,10 @@ func TestAccBarGaugeDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.gdashboard_bar_gauge.test", "json", testAccBarGaugeDataSourceProviderDefaultsConfigExpectedJson),
 				),
 			},
+			{
+				Config:      testAccBarGaugeDataSourceInvalidVizHeightDefaultsConfig,
+				ExpectError: regexp.MustCompile("including the provider defaults, got: min_viz_height = 200, max_viz_height = 100"),
+			},
 		},
 	})
 }
@@ -43,12 +48,23 @@ data "gdashboard_bar_gauge" "test" {
   description = "Bar gauge description"
 
   field {
-    decimals = 0
+    decimals      = 0
+    display_name  = "$${__field.labels.job_type}"
+    description   = "The number of jobs per type"
+    filterable    = true
+    field_min_max = true
   }
 
   graph {
-    orientation  = "horizontal"
-    display_mode = "basic"
+    orientation    = "horizontal"
+    display_mode   = "basic"
+    value_mode     = "text"
+    name_placement = "top"
+    show_unfilled  = false
+    sizing         = "manual"
+    min_viz_width  = 10
+    min_viz_height = 20
+    max_viz_height = 100
 
     options {
       values      = true
@@ -71,6 +87,11 @@ data "gdashboard_bar_gauge" "test" {
 		max      = 10
 		decimals = 1
 		no_value = "1"
+        display_name = "Total"
+        link {
+          title = "Details"
+          url   = "/d/details?var-value=$${__value.raw}"
+        }
         color {
 		  mode        = "fixed"
       	  fixed_color = "red"	
@@ -80,6 +101,14 @@ data "gdashboard_bar_gauge" "test" {
           step {
             color = "red"
           }
+          step {
+            color = "#F2495C"
+            value = 80
+          }
+          step {
+            color = "rgba(245, 54, 54, 0.9)"
+            value = 95
+          }
         }
 	    mappings {
           value {
@@ -126,6 +155,13 @@ const testAccBarGaugeDataSourceConfigExpectedJson = `{
     "displayMode": "basic",
     "content": "",
     "mode": "",
+    "valueMode": "text",
+    "namePlacement": "top",
+    "showUnfilled": false,
+    "maxVizHeight": 100,
+    "sizing": "manual",
+    "minVizWidth": 10,
+    "minVizHeight": 20,
     "text": {
       "titleSize": 10,
       "valueSize": 15
@@ -164,6 +200,10 @@ const testAccBarGaugeDataSourceConfigExpectedJson = `{
     "defaults": {
       "unit": "",
       "decimals": 0,
+      "displayName": "${__field.labels.job_type}",
+      "description": "The number of jobs per type",
+      "filterable": true,
+      "fieldMinMax": true,
       "color": {
         "mode": "palette-classic",
         "fixedColor": "green",
@@ -236,6 +276,10 @@ const testAccBarGaugeDataSourceConfigExpectedJson = `{
             "id": "noValue",
             "value": 1
           },
+          {
+            "id": "displayName",
+            "value": "Total"
+          },
           {
             "id": "color",
             "value": {
@@ -258,6 +302,15 @@ const testAccBarGaugeDataSourceConfigExpectedJson = `{
               }
             ]
           },
+          {
+            "id": "links",
+            "value": [
+              {
+                "title": "Details",
+                "url": "/d/details?var-value=${__value.raw}"
+              }
+            ]
+          },
           {
             "id": "thresholds",
             "value": {
@@ -266,6 +319,14 @@ const testAccBarGaugeDataSourceConfigExpectedJson = `{
                 {
                   "color": "red",
                   "value": null
+                },
+                {
+                  "color": "#f2495c",
+                  "value": 80
+                },
+                {
+                  "color": "rgba(245, 54, 54, 0.9)",
+                  "value": 95
                 }
               ]
             }
@@ -285,6 +346,10 @@ provider "gdashboard" {
         decimals = 1
         min      = 0
         max      = 10000
+        no_value = 0
+
+        filterable    = false
+        field_min_max = false
         
         color { 
           mode        = "palette-classic"
@@ -294,8 +359,10 @@ provider "gdashboard" {
       }
 
 	  graph {
-        orientation  = "vertical"
-        display_mode = "lcd"
+        orientation    = "vertical"
+        display_mode   = "lcd"
+        value_mode     = "hidden"
+        name_placement = "left"
 
         options {
           values      = true
@@ -331,6 +398,13 @@ const testAccBarGaugeDataSourceProviderCustomDefaultsConfigExpectedJson = `{
     "displayMode": "lcd",
     "content": "",
     "mode": "",
+    "valueMode": "hidden",
+    "namePlacement": "left",
+    "showUnfilled": true,
+    "maxVizHeight": 300,
+    "sizing": "auto",
+    "minVizWidth": 8,
+    "minVizHeight": 16,
     "text": {},
     "reduceOptions": {
       "values": true,
@@ -346,6 +420,9 @@ const testAccBarGaugeDataSourceProviderCustomDefaultsConfigExpectedJson = `{
       "decimals": 1,
       "min": 0,
       "max": 10000,
+      "noValue": 0,
+      "filterable": false,
+      "fieldMinMax": false,
       "color": {
         "mode": "palette-classic",
         "fixedColor": "red",
@@ -419,6 +496,13 @@ const testAccBarGaugeDataSourceProviderDefaultsConfigExpectedJson = `{
     "displayMode": "gradient",
     "content": "",
     "mode": "",
+    "valueMode": "color",
+    "namePlacement": "auto",
+    "showUnfilled": true,
+    "maxVizHeight": 300,
+    "sizing": "auto",
+    "minVizWidth": 8,
+    "minVizHeight": 16,
     "text": {},
     "reduceOptions": {
       "values": false,
@@ -478,3 +562,23 @@ const testAccBarGaugeDataSourceProviderDefaultsConfigExpectedJson = `{
     }
   }
 }`
+
+const testAccBarGaugeDataSourceInvalidVizHeightDefaultsConfig = `
+provider "gdashboard" {
+  defaults {
+    bar_gauge {
+      graph {
+        max_viz_height = 100
+      }
+    }
+  }
+}
+
+data "gdashboard_bar_gauge" "test" {
+  title = "Test"
+
+  graph {
+    min_viz_height = 200
+  }
+}
+`
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"strings"
+)
+
+// colorHues are the hues of the Grafana named color palette.
+var colorHues = []string{"red", "orange", "yellow", "green", "blue", "purple"}
+
+// colorShades are the prefixes of the shades of every hue, from the lightest to the darkest one.
+var colorShades = []string{"super-light-", "light-", "", "semi-dark-", "dark-"}
+
+// namedColors returns the named colors of the Grafana palette, e.g. `semi-dark-red`.
+func namedColors() []string {
+	colors := []string{"transparent", "text"}
+
+	for _, hue := range colorHues {
+		for _, shade := range colorShades {
+			colors = append(colors, shade+hue)
+		}
+	}
+
+	return colors
+}
+
+// isNamedColor reports whether the color is a named color of the Grafana palette, regardless of the case.
+func isNamedColor(color string) bool {
+	for _, named := range namedColors() {
+		if strings.EqualFold(color, named) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeColor lowercases the hex and the named colors, so the same color is always rendered the same way.
+func normalizeColor(color string) string {
+	if strings.HasPrefix(color, "#") || isNamedColor(color) {
+		return strings.ToLower(color)
+	}
+
+	return color
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -15,6 +16,7 @@ import (
 	"github.com/iRevive/terraform-provider-gdashboard/internal/provider/grafana"
 	"math"
 	"strconv"
+	"strings"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces
@@ -30,10 +32,12 @@ type DashboardDataSource struct {
 }
 
 type DashboardDefaults struct {
-	Editable     bool
-	Style        string
-	GraphTooltip string
-	Time         Time
+	Editable           bool
+	Style              string
+	GraphTooltip       string
+	Time               Time
+	BuiltInAnnotations bool
+	Annotations        []grafana.Annotation
 }
 
 type Time struct {
@@ -43,16 +47,85 @@ type Time struct {
 
 // DashboardDataSourceModel describes the data source data model.
 type DashboardDataSourceModel struct {
-	Id           types.String `tfsdk:"id"`
-	Json         types.String `tfsdk:"json"`
-	Title        types.String `tfsdk:"title"`
-	UID          types.String `tfsdk:"uid"`
-	Editable     types.Bool   `tfsdk:"editable"`
-	Style        types.String `tfsdk:"style"`
-	GraphTooltip types.String `tfsdk:"graph_tooltip"`
-	Time         []TimeModel  `tfsdk:"time"`
-	Layout       Layout       `tfsdk:"layout"`
-	Variables    []Variable   `tfsdk:"variables"`
+	Id           types.String     `tfsdk:"id"`
+	Json         types.String     `tfsdk:"json"`
+	Title        types.String     `tfsdk:"title"`
+	UID          types.String     `tfsdk:"uid"`
+	Editable     types.Bool       `tfsdk:"editable"`
+	Style        types.String     `tfsdk:"style"`
+	GraphTooltip types.String     `tfsdk:"graph_tooltip"`
+	Time         []TimeModel      `tfsdk:"time"`
+	Layout       Layout           `tfsdk:"layout"`
+	Variables    []Variable       `tfsdk:"variables"`
+	Links        []DashboardLinks `tfsdk:"links"`
+	Annotations  []Annotations    `tfsdk:"annotations"`
+}
+
+type DashboardLinks struct {
+	Dashboards []DashboardsLink `tfsdk:"dashboards"`
+	Link       []URLLink        `tfsdk:"link"`
+}
+
+type DashboardsLink struct {
+	Title            types.String   `tfsdk:"title"`
+	Tags             []types.String `tfsdk:"tags"`
+	AsDropdown       types.Bool     `tfsdk:"as_dropdown"`
+	IncludeVariables types.Bool     `tfsdk:"include_variables"`
+	IncludeTimeRange types.Bool     `tfsdk:"include_time_range"`
+	TargetBlank      types.Bool     `tfsdk:"target_blank"`
+}
+
+type URLLink struct {
+	Title            types.String `tfsdk:"title"`
+	Url              types.String `tfsdk:"url"`
+	Icon             types.String `tfsdk:"icon"`
+	Tooltip          types.String `tfsdk:"tooltip"`
+	IncludeVariables types.Bool   `tfsdk:"include_variables"`
+	IncludeTimeRange types.Bool   `tfsdk:"include_time_range"`
+	TargetBlank      types.Bool   `tfsdk:"target_blank"`
+}
+
+type Annotations struct {
+	BuiltIn    types.Bool             `tfsdk:"built_in"`
+	Prometheus []PrometheusAnnotation `tfsdk:"prometheus"`
+	Loki       []LokiAnnotation       `tfsdk:"loki"`
+	Grafana    []GrafanaAnnotation    `tfsdk:"grafana"`
+}
+
+type PrometheusAnnotation struct {
+	Name                   types.String   `tfsdk:"name"`
+	DatasourceUid          types.String   `tfsdk:"datasource_uid"`
+	Expr                   types.String   `tfsdk:"expr"`
+	Step                   types.String   `tfsdk:"step"`
+	TitleFormat            types.String   `tfsdk:"title_format"`
+	TextFormat             types.String   `tfsdk:"text_format"`
+	TagKeys                []types.String `tfsdk:"tag_keys"`
+	SeriesValueAsTimestamp types.Bool     `tfsdk:"series_value_as_timestamp"`
+	Color                  types.String   `tfsdk:"color"`
+	Enable                 types.Bool     `tfsdk:"enable"`
+	Hide                   types.Bool     `tfsdk:"hide"`
+}
+
+type LokiAnnotation struct {
+	Name          types.String   `tfsdk:"name"`
+	DatasourceUid types.String   `tfsdk:"datasource_uid"`
+	Expr          types.String   `tfsdk:"expr"`
+	TitleFormat   types.String   `tfsdk:"title_format"`
+	TextFormat    types.String   `tfsdk:"text_format"`
+	TagKeys       []types.String `tfsdk:"tag_keys"`
+	Color         types.String   `tfsdk:"color"`
+	Enable        types.Bool     `tfsdk:"enable"`
+	Hide          types.Bool     `tfsdk:"hide"`
+}
+
+type GrafanaAnnotation struct {
+	Name     types.String   `tfsdk:"name"`
+	Tags     []types.String `tfsdk:"tags"`
+	MatchAny types.Bool     `tfsdk:"match_any"`
+	Limit    types.Int64    `tfsdk:"limit"`
+	Color    types.String   `tfsdk:"color"`
+	Enable   types.Bool     `tfsdk:"enable"`
+	Hide     types.Bool     `tfsdk:"hide"`
 }
 
 type Layout struct {
@@ -74,14 +147,25 @@ type Size struct {
 }
 
 type Variable struct {
-	Custom   []VariableCustom   `tfsdk:"custom"`
-	Constant []VariableConstant `tfsdk:"const"`
+	Source     types.String         `tfsdk:"source"`
+	Custom     []VariableCustom     `tfsdk:"custom"`
+	Constant   []VariableConstant   `tfsdk:"const"`
+	Query      []VariableQuery      `tfsdk:"query"`
+	Datasource []VariableDatasource `tfsdk:"datasource"`
+	Interval   []VariableInterval   `tfsdk:"interval"`
+	Textbox    []VariableTextbox    `tfsdk:"textbox"`
+	AdHoc      []VariableAdHoc      `tfsdk:"adhoc"`
 }
 
 type VariableCustom struct {
-	Name    types.String           `tfsdk:"name"`
-	Hide    types.String           `tfsdk:"hide"`
-	Options []VariableCustomOption `tfsdk:"option"`
+	Name        types.String           `tfsdk:"name"`
+	Label       types.String           `tfsdk:"label"`
+	Description types.String           `tfsdk:"description"`
+	Hide        types.String           `tfsdk:"hide"`
+	Multi       types.Bool             `tfsdk:"multi"`
+	IncludeAll  types.Bool             `tfsdk:"include_all"`
+	AllValue    types.String           `tfsdk:"all_value"`
+	Options     []VariableCustomOption `tfsdk:"option"`
 }
 
 type VariableCustomOption struct {
@@ -96,6 +180,71 @@ type VariableConstant struct {
 	Hide  types.String `tfsdk:"hide"`
 }
 
+type VariableQuery struct {
+	Name           types.String   `tfsdk:"name"`
+	Hide           types.String   `tfsdk:"hide"`
+	DatasourceUid  types.String   `tfsdk:"datasource_uid"`
+	DatasourceType types.String   `tfsdk:"datasource_type"`
+	Query          types.String   `tfsdk:"query"`
+	Regex          types.String   `tfsdk:"regex"`
+	Sort           types.String   `tfsdk:"sort"`
+	Refresh        types.String   `tfsdk:"refresh"`
+	Multi          types.Bool     `tfsdk:"multi"`
+	IncludeAll     types.Bool     `tfsdk:"include_all"`
+	AllValue       types.String   `tfsdk:"all_value"`
+	Current        []types.String `tfsdk:"current"`
+}
+
+type VariableDatasource struct {
+	Name       types.String   `tfsdk:"name"`
+	Hide       types.String   `tfsdk:"hide"`
+	Type       types.String   `tfsdk:"type"`
+	Regex      types.String   `tfsdk:"regex"`
+	Multi      types.Bool     `tfsdk:"multi"`
+	IncludeAll types.Bool     `tfsdk:"include_all"`
+	Current    []types.String `tfsdk:"current"`
+}
+
+type VariableInterval struct {
+	Name      types.String   `tfsdk:"name"`
+	Hide      types.String   `tfsdk:"hide"`
+	Intervals []types.String `tfsdk:"intervals"`
+	Auto      types.Bool     `tfsdk:"auto"`
+	AutoCount types.Int64    `tfsdk:"auto_count"`
+	AutoMin   types.String   `tfsdk:"auto_min"`
+	Current   types.String   `tfsdk:"current"`
+}
+
+type VariableTextbox struct {
+	Name         types.String `tfsdk:"name"`
+	Hide         types.String `tfsdk:"hide"`
+	DefaultValue types.String `tfsdk:"default_value"`
+}
+
+type VariableAdHoc struct {
+	Name           types.String `tfsdk:"name"`
+	Hide           types.String `tfsdk:"hide"`
+	DatasourceUid  types.String `tfsdk:"datasource_uid"`
+	DatasourceType types.String `tfsdk:"datasource_type"`
+}
+
+// variableSorts maps the sort orders of the variable options to the values expected by Grafana
+var variableSorts = map[string]int{
+	"disabled":                           0,
+	"alphabetical-asc":                   1,
+	"alphabetical-desc":                  2,
+	"numerical-asc":                      3,
+	"numerical-desc":                     4,
+	"alphabetical-case-insensitive-asc":  5,
+	"alphabetical-case-insensitive-desc": 6,
+}
+
+// variableRefreshes maps the refresh modes of the variable options to the values expected by Grafana
+var variableRefreshes = map[string]int64{
+	"on-dashboard-load":    1,
+	"on-time-range-change": 2,
+}
+
 func (d *DashboardDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_dashboard"
 }
@@ -128,6 +277,17 @@ func dashboardEditableAttribute() schema.Attribute {
 	}
 }
 
+func variableHideAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		Description:         "Which variable information to hide. The choices are: label, variable.",
+		MarkdownDescription: "Which variable information to hide. The choices are: `label`, `variable`.",
+		Validators: []validator.String{
+			stringvalidator.OneOf("label", "variable"),
+		},
+	}
+}
+
 func dashboardStyleAttribute() schema.Attribute {
 	return schema.StringAttribute{
 		Optional:            true,
@@ -150,96 +310,602 @@ func dashboardGraphTooltipAttribute() schema.Attribute {
 	}
 }
 
-func (d *DashboardDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		// This description is used by the documentation generator and the language server.
-		Description:         "Dashboard data source.",
-		MarkdownDescription: "Dashboard data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/dashboards/use-dashboards/) for more details.",
-
-		Blocks: map[string]schema.Block{
-			"time": dashboardTimeBlock(),
-			"variables": schema.ListNestedBlock{
-				Description: "The variables.",
-				NestedObject: schema.NestedBlockObject{
-					Blocks: map[string]schema.Block{
-						"custom": schema.ListNestedBlock{
-							Description: "The variable options defined as a comma-separated list.",
-
-							NestedObject: schema.NestedBlockObject{
-								Blocks: map[string]schema.Block{
-									"option": schema.ListNestedBlock{
-										Description: "The option entry.",
-										NestedObject: schema.NestedBlockObject{
-											Attributes: map[string]schema.Attribute{
-												"text": schema.StringAttribute{
-													Required:    true,
-													Description: "The text (label) of the entry.",
-												},
-												"value": schema.StringAttribute{
-													Required:    true,
-													Description: "The value of the entry.",
-												},
-												"selected": schema.BoolAttribute{
-													Optional:    true,
-													Description: "Whether to mark the option as selected or not.",
-												},
-											},
-										},
-										Validators: []validator.List{
-											listvalidator.SizeAtLeast(1),
-											listvalidator.SizeAtMost(10),
-										},
-									},
+func dashboardLinksBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The links to other dashboards or websites, displayed below the dashboard title.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"dashboards": schema.ListNestedBlock{
+					Description: "The links to the dashboards with the given tags.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"title": schema.StringAttribute{
+								Optional:    true,
+								Description: "The title of the dropdown. Used only when the links are shown as a dropdown.",
+							},
+							"tags": schema.ListAttribute{
+								Required:    true,
+								ElementType: types.StringType,
+								Description: "The tags of the dashboards to link to.",
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
 								},
-								Attributes: map[string]schema.Attribute{
-									"name": schema.StringAttribute{
-										Required:    true,
-										Description: "The name of the variable.",
-									},
-									"hide": schema.StringAttribute{
-										Optional:            true,
-										Description:         "Which variable information to hide. The choices are: label, variable.",
-										MarkdownDescription: "Which variable information to hide. The choices are: `label`, `variable`.",
-										Validators: []validator.String{
-											stringvalidator.OneOf("label", "variable"),
-										},
-									},
+							},
+							"as_dropdown": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to show the links as a dropdown or not. Otherwise, the links are shown side by side.",
+							},
+							"include_variables": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to pass the variables of the dashboard to the linked dashboards or not.",
+							},
+							"include_time_range": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to pass the time range of the dashboard to the linked dashboards or not.",
+							},
+							"target_blank": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to open the links in a new tab or not.",
+							},
+						},
+					},
+				},
+				"link": schema.ListNestedBlock{
+					Description: "The link to a URL.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"title": schema.StringAttribute{
+								Required:    true,
+								Description: "The title of the link.",
+							},
+							"url": schema.StringAttribute{
+								Required:    true,
+								Description: "The URL to open.",
+							},
+							"icon": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The icon of the link. The choices are: external link, dashboard, question, info, bolt, doc, cloud. The default is external link.",
+								MarkdownDescription: "The icon of the link. The choices are: `external link`, `dashboard`, `question`, `info`, `bolt`, `doc`, `cloud`. The default is `external link`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("external link", "dashboard", "question", "info", "bolt", "doc", "cloud"),
 								},
 							},
+							"tooltip": schema.StringAttribute{
+								Optional:    true,
+								Description: "The tooltip to show when hovering over the link.",
+							},
+							"include_variables": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to add the variables of the dashboard to the URL or not.",
+							},
+							"include_time_range": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to add the time range of the dashboard to the URL or not.",
+							},
+							"target_blank": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to open the link in a new tab or not.",
+							},
+						},
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
 
-							Validators: []validator.List{
-								listvalidator.SizeAtLeast(1),
-								listvalidator.SizeAtMost(10),
+func dashboardAnnotationsBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The annotations to show on the panels of the dashboard. " +
+			"The annotations of the provider defaults are added after the ones of the dashboard, except the ones whose name is already used by the dashboard.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"built_in": schema.BoolAttribute{
+					Optional:            true,
+					Description:         "Whether to add the built-in Annotations & Alerts entry or not. The default is true.",
+					MarkdownDescription: "Whether to add the built-in `Annotations & Alerts` entry or not. The default is `true`.",
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"prometheus": schema.ListNestedBlock{
+					Description: "The annotations created from a Prometheus query.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								Required:    true,
+								Description: "The name of the annotations.",
+							},
+							"datasource_uid": schema.StringAttribute{
+								Required:            true,
+								Description:         "The UID of a Prometheus DataSource to use in this query. A datasource variable can be referenced as $var or $${var}.",
+								MarkdownDescription: "The UID of a Prometheus DataSource to use in this query. A datasource variable can be referenced as `$var` or `$${var}`.",
+							},
+							"expr": schema.StringAttribute{
+								Required:    true,
+								Description: "The query expression.",
+							},
+							"title_format": schema.StringAttribute{
+								Optional:    true,
+								Description: "The title of the annotations. The labels of the series can be referenced the same way as in the legend format.",
+							},
+							"text_format": schema.StringAttribute{
+								Optional:    true,
+								Description: "The text of the annotations. The labels of the series can be referenced the same way as in the legend format.",
+							},
+							"tag_keys": schema.ListAttribute{
+								Optional:    true,
+								ElementType: types.StringType,
+								Description: "The labels to use as the tags of the annotations.",
+							},
+							"step": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The step of the query. The default is 60s.",
+								MarkdownDescription: "The step of the query. The default is `60s`.",
+							},
+							"series_value_as_timestamp": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to use the value of the series as the timestamp of the annotations or not.",
+							},
+							"color": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The color of the annotations. The default is red.",
+								MarkdownDescription: "The color of the annotations. The default is `red`.",
+								Validators: []validator.String{
+									grafanaColor(),
+								},
+							},
+							"enable": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to show the annotations by default or not. The default is true.",
+							},
+							"hide": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to hide the annotations toggle in the dashboard controls or not.",
 							},
 						},
-						"const": schema.ListNestedBlock{
-							Description: "The constant variable.",
-							NestedObject: schema.NestedBlockObject{
-								Attributes: map[string]schema.Attribute{
-									"name": schema.StringAttribute{
-										Description: "The name of the variable.",
-										Required:    true,
-									},
-									"value": schema.StringAttribute{
-										Description: "The value of the variable.",
-										Required:    true,
-									},
-									"hide": schema.StringAttribute{
-										Optional:            true,
-										Description:         "Which variable information to hide. The choices are: label, variable.",
-										MarkdownDescription: "Which variable information to hide. The choices are: `label`, `variable`.",
-										Validators: []validator.String{
-											stringvalidator.OneOf("label", "variable"),
-										},
-									},
+					},
+				},
+				"loki": schema.ListNestedBlock{
+					Description: "The annotations created from a Loki query.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								Required:    true,
+								Description: "The name of the annotations.",
+							},
+							"datasource_uid": schema.StringAttribute{
+								Required:            true,
+								Description:         "The UID of a Loki DataSource to use in this query. A datasource variable can be referenced as $var or $${var}.",
+								MarkdownDescription: "The UID of a Loki DataSource to use in this query. A datasource variable can be referenced as `$var` or `$${var}`.",
+							},
+							"expr": schema.StringAttribute{
+								Required:    true,
+								Description: "The query expression.",
+							},
+							"title_format": schema.StringAttribute{
+								Optional:    true,
+								Description: "The title of the annotations. The labels of the series can be referenced the same way as in the legend format.",
+							},
+							"text_format": schema.StringAttribute{
+								Optional:    true,
+								Description: "The text of the annotations. The labels of the series can be referenced the same way as in the legend format.",
+							},
+							"tag_keys": schema.ListAttribute{
+								Optional:    true,
+								ElementType: types.StringType,
+								Description: "The labels to use as the tags of the annotations.",
+							},
+							"color": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The color of the annotations. The default is red.",
+								MarkdownDescription: "The color of the annotations. The default is `red`.",
+								Validators: []validator.String{
+									grafanaColor(),
 								},
 							},
-							Validators: []validator.List{
-								listvalidator.SizeAtMost(5),
+							"enable": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to show the annotations by default or not. The default is true.",
+							},
+							"hide": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to hide the annotations toggle in the dashboard controls or not.",
 							},
 						},
 					},
 				},
+				"grafana": schema.ListNestedBlock{
+					Description: "The annotations stored in Grafana, filtered by tags.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								Required:    true,
+								Description: "The name of the annotations.",
+							},
+							"tags": schema.ListAttribute{
+								Required:    true,
+								ElementType: types.StringType,
+								Description: "The tags to filter the annotations by.",
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
+								},
+							},
+							"match_any": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to show the annotations with any of the tags or not. By default, the annotations must have all the tags.",
+							},
+							"limit": schema.Int64Attribute{
+								Optional:            true,
+								Description:         "The maximum number of annotations to show. The default is 100.",
+								MarkdownDescription: "The maximum number of annotations to show. The default is `100`.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"color": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The color of the annotations. The default is red.",
+								MarkdownDescription: "The color of the annotations. The default is `red`.",
+								Validators: []validator.String{
+									grafanaColor(),
+								},
+							},
+							"enable": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to show the annotations by default or not. The default is true.",
+							},
+							"hide": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to hide the annotations toggle in the dashboard controls or not.",
+							},
+						},
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func variableBlocks() map[string]schema.Block {
+	return map[string]schema.Block{
+		"custom": schema.ListNestedBlock{
+			Description: "The variable options defined as a comma-separated list.",
+
+			NestedObject: schema.NestedBlockObject{
+				Blocks: map[string]schema.Block{
+					"option": schema.ListNestedBlock{
+						Description: "The option entry.",
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"text": schema.StringAttribute{
+									Required:    true,
+									Description: "The text (label) of the entry.",
+								},
+								"value": schema.StringAttribute{
+									Required:    true,
+									Description: "The value of the entry.",
+								},
+								"selected": schema.BoolAttribute{
+									Optional:            true,
+									Description:         "Whether to mark the option as selected or not. Multiple options can be selected only when multi is enabled.",
+									MarkdownDescription: "Whether to mark the option as selected or not. Multiple options can be selected only when `multi` is enabled.",
+								},
+							},
+						},
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.SizeAtMost(10),
+							multiSelection(),
+						},
+					},
+				},
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required:    true,
+						Description: "The name of the variable.",
+					},
+					"label": schema.StringAttribute{
+						Optional:    true,
+						Description: "The display name of the variable. The name is displayed by default.",
+					},
+					"description": schema.StringAttribute{
+						Optional:    true,
+						Description: "The description of the variable, displayed as a tooltip of the label.",
+					},
+					"hide": variableHideAttribute(),
+					"multi": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether to allow selecting multiple values at the same time or not.",
+					},
+					"include_all": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether to include the `All` option or not.",
+					},
+					"all_value": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The custom value of the All option. By default, the All option combines the values of all options.",
+						MarkdownDescription: "The custom value of the `All` option. By default, the `All` option combines the values of all options.",
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("include_all")),
+						},
+					},
+				},
+			},
+
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(10),
+			},
+		},
+		"const": schema.ListNestedBlock{
+			Description: "The constant variable.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "The name of the variable.",
+						Required:    true,
+					},
+					"value": schema.StringAttribute{
+						Description: "The value of the variable.",
+						Required:    true,
+					},
+					"hide": variableHideAttribute(),
+				},
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(5),
+			},
+		},
+		"query": schema.ListNestedBlock{
+			Description: "The variable options fetched from a data source query.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required:    true,
+						Description: "The name of the variable.",
+					},
+					"hide": variableHideAttribute(),
+					"datasource_uid": schema.StringAttribute{
+						Required:    true,
+						Description: "The UID of a DataSource to use in this query.",
+					},
+					"datasource_type": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The type of the DataSource plugin, e.g. prometheus or loki. The default is prometheus.",
+						MarkdownDescription: "The type of the DataSource plugin, e.g. `prometheus` or `loki`. The default is `prometheus`.",
+					},
+					"query": schema.StringAttribute{
+						Required:            true,
+						Description:         "The query to fetch the options with, e.g. label_values(up, instance) or query_result(count by (job) (up)) for Prometheus.",
+						MarkdownDescription: "The query to fetch the options with, e.g. `label_values(up, instance)` or `query_result(count by (job) (up))` for Prometheus.",
+					},
+					"regex": schema.StringAttribute{
+						Optional:    true,
+						Description: "The regex to filter or capture specific parts of the names returned by the query.",
+					},
+					"sort": schema.StringAttribute{
+						Optional: true,
+						Description: "The sort order of the options. The choices are: disabled, alphabetical-asc, alphabetical-desc, numerical-asc, numerical-desc, " +
+							"alphabetical-case-insensitive-asc, alphabetical-case-insensitive-desc.",
+						MarkdownDescription: "The sort order of the options. The choices are: `disabled`, `alphabetical-asc`, `alphabetical-desc`, `numerical-asc`, `numerical-desc`, " +
+							"`alphabetical-case-insensitive-asc`, `alphabetical-case-insensitive-desc`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								"disabled", "alphabetical-asc", "alphabetical-desc", "numerical-asc", "numerical-desc",
+								"alphabetical-case-insensitive-asc", "alphabetical-case-insensitive-desc",
+							),
+						},
+					},
+					"refresh": schema.StringAttribute{
+						Optional:            true,
+						Description:         "When to update the options. The choices are: on-dashboard-load, on-time-range-change. The default is on-dashboard-load.",
+						MarkdownDescription: "When to update the options. The choices are: `on-dashboard-load`, `on-time-range-change`. The default is `on-dashboard-load`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("on-dashboard-load", "on-time-range-change"),
+						},
+					},
+					"multi": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether to allow selecting multiple values at the same time or not.",
+					},
+					"include_all": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether to include the `All` option or not.",
+					},
+					"all_value": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The custom value of the All option, e.g. .* for Prometheus.",
+						MarkdownDescription: "The custom value of the `All` option, e.g. `.*` for Prometheus.",
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("include_all")),
+						},
+					},
+					"current": schema.ListAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "The values selected by default. Multiple values require multi to be enabled.",
+						MarkdownDescription: "The values selected by default. Multiple values require `multi` to be enabled.",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							multiSelection(),
+						},
+					},
+				},
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(10),
+			},
+		},
+		"datasource": schema.ListNestedBlock{
+			Description: "The variable options defined as the data source instances of the given type.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required:    true,
+						Description: "The name of the variable.",
+					},
+					"hide": variableHideAttribute(),
+					"type": schema.StringAttribute{
+						Required:            true,
+						Description:         "The type of the DataSource plugin, e.g. prometheus or cloudwatch.",
+						MarkdownDescription: "The type of the DataSource plugin, e.g. `prometheus` or `cloudwatch`.",
+					},
+					"regex": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The regex to filter the data source instances by name, e.g. /prometheus-eu-.*/.",
+						MarkdownDescription: "The regex to filter the data source instances by name, e.g. `/prometheus-eu-.*/`.",
+					},
+					"multi": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether to allow selecting multiple values at the same time or not.",
+					},
+					"include_all": schema.BoolAttribute{
+						Optional:    true,
+						Description: "Whether to include the `All` option or not.",
+					},
+					"current": schema.ListAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "The data source instances selected by default. Multiple values require multi to be enabled.",
+						MarkdownDescription: "The data source instances selected by default. Multiple values require `multi` to be enabled.",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							multiSelection(),
+						},
+					},
+				},
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(10),
+			},
+		},
+		"interval": schema.ListNestedBlock{
+			Description: "The variable options defined as time spans, e.g. to select the step of the queries.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required:    true,
+						Description: "The name of the variable.",
+					},
+					"hide": variableHideAttribute(),
+					"intervals": schema.ListAttribute{
+						Required:            true,
+						ElementType:         types.StringType,
+						Description:         "The time spans to choose from, e.g. 1m, 10m, 1h.",
+						MarkdownDescription: "The time spans to choose from, e.g. `1m`, `10m`, `1h`.",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+					"auto": schema.BoolAttribute{
+						Optional:            true,
+						Description:         "Whether to add the auto option or not. The auto option divides the time range by the step count.",
+						MarkdownDescription: "Whether to add the `auto` option or not. The `auto` option divides the time range by the step count.",
+					},
+					"auto_count": schema.Int64Attribute{
+						Optional:            true,
+						Description:         "The number of times the time range is divided by when the auto option is selected. The default is 30.",
+						MarkdownDescription: "The number of times the time range is divided by when the `auto` option is selected. The default is `30`.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("auto")),
+						},
+					},
+					"auto_min": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The minimum time span of the auto option. The default is 10s.",
+						MarkdownDescription: "The minimum time span of the `auto` option. The default is `10s`.",
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("auto")),
+						},
+					},
+					"current": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The time span selected by default. Must be one of the intervals or auto.",
+						MarkdownDescription: "The time span selected by default. Must be one of the `intervals` or `auto`.",
+					},
+				},
+				Validators: []validator.Object{
+					intervalCurrent(),
+				},
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(10),
+			},
+		},
+		"textbox": schema.ListNestedBlock{
+			Description: "The variable defined as a free-text input field.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required:    true,
+						Description: "The name of the variable.",
+					},
+					"hide": variableHideAttribute(),
+					"default_value": schema.StringAttribute{
+						Optional:    true,
+						Description: "The default value of the input field.",
+					},
+				},
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(10),
+			},
+		},
+		"adhoc": schema.ListNestedBlock{
+			Description: "The ad hoc filters variable. The key/value filters are automatically added to all queries that use the data source.",
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required:    true,
+						Description: "The name of the variable.",
+					},
+					"hide": variableHideAttribute(),
+					"datasource_uid": schema.StringAttribute{
+						Required:    true,
+						Description: "The UID of a DataSource to apply the filters to.",
+					},
+					"datasource_type": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The type of the DataSource plugin, e.g. prometheus or loki. The default is prometheus.",
+						MarkdownDescription: "The type of the DataSource plugin, e.g. `prometheus` or `loki`. The default is `prometheus`.",
+					},
+				},
+			},
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(5),
+			},
+		},
+	}
+}
+
+func (d *DashboardDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description:         "Dashboard data source.",
+		MarkdownDescription: "Dashboard data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/dashboards/use-dashboards/) for more details.",
+
+		Blocks: map[string]schema.Block{
+			"time":        dashboardTimeBlock(),
+			"links":       dashboardLinksBlock(),
+			"annotations": dashboardAnnotationsBlock(),
+			"variables": schema.ListNestedBlock{
+				Description: "The variables. The names of the variables must be unique.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Optional:            true,
+							Description:         "The JSON source of the variable, e.g. the json of the gdashboard_variable data source. The source goes before the inline variables of the same block.",
+							MarkdownDescription: "The JSON source of the variable, e.g. the `json` of the `gdashboard_variable` data source. The source goes before the inline variables of the same block.",
+						},
+					},
+					Blocks: variableBlocks(),
+				},
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(10),
 				},
@@ -346,83 +1012,56 @@ func (d *DashboardDataSource) Read(ctx context.Context, req datasource.ReadReque
 	}
 
 	vars := make([]grafana.TemplateVar, 0)
-	for _, variable := range data.Variables {
-		for _, custom := range variable.Custom {
-			opts := make([]grafana.Option, len(custom.Options))
-			query := ""
-			var current grafana.Current
-
-			for i, opt := range custom.Options {
-				opts[i] = grafana.Option{
-					Text:     opt.Text.ValueString(),
-					Value:    opt.Value.ValueString(),
-					Selected: opt.Selected.ValueBool(),
-				}
+	names := make(map[string]bool)
 
-				if query != "" {
-					query = query + ", "
-				}
+	for i, variable := range data.Variables {
+		created := make([]grafana.TemplateVar, 0)
 
-				query = query + opt.Text.ValueString() + " : " + opt.Value.ValueString()
+		if !variable.Source.IsNull() {
+			var v grafana.TemplateVar
 
-				if opt.Selected.ValueBool() {
-					current = grafana.Current{
-						Text: &grafana.StringSliceString{
-							Value: []string{opt.Text.ValueString()},
-							Valid: true,
-						},
-						Value: opt.Value.ValueString(),
-					}
-				}
+			err := json.Unmarshal([]byte(variable.Source.ValueString()), &v)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("variables").AtListIndex(i).AtName("source"),
+					"Client Error",
+					fmt.Sprintf("Could not unmarshall json as Variable: %s", err),
+				)
+				return
 			}
 
-			hide := uint8(0)
+			created = append(created, v)
+		}
 
-			if !custom.Hide.IsNull() {
-				switch v := custom.Hide.ValueString(); v {
-				case "label":
-					hide = 1
-				case "variable":
-					hide = 2
-				default:
-					hide = 0
-				}
-			}
+		created = append(created, createTemplateVars(variable)...)
 
-			v := grafana.TemplateVar{
-				Type:    "custom",
-				Name:    custom.Name.ValueString(),
-				Options: opts,
-				Query:   query,
-				Current: current,
-				Hide:    hide,
+		for _, v := range created {
+			if names[v.Name] {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("variables").AtListIndex(i),
+					"Duplicate Variable Name",
+					fmt.Sprintf("The variable name %q is already used by another variable. The names of the variables must be unique.", v.Name),
+				)
 			}
 
-			vars = append(vars, v)
+			names[v.Name] = true
 		}
 
-		for _, c := range variable.Constant {
-			hide := uint8(0)
+		vars = append(vars, created...)
+	}
 
-			if !c.Hide.IsNull() {
-				switch v := c.Hide.ValueString(); v {
-				case "label":
-					hide = 1
-				case "variable":
-					hide = 2
-				default:
-					hide = 0
-				}
-			}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-			v := grafana.TemplateVar{
-				Type:  "constant",
-				Name:  c.Name.ValueString(),
-				Query: c.Value.ValueString(),
-				Hide:  hide,
+	for _, v := range vars {
+		if uid, ok := datasourceRefUID(v.Datasource); ok {
+			if name, ok := datasourceVariable(uid); ok && !names[name] {
+				resp.Diagnostics.AddError(
+					"Unknown Datasource Variable",
+					fmt.Sprintf("Variable %s queries the data source %s, but the dashboard has no variable named %q.", v.Name, uid, name),
+				)
 			}
-
-			vars = append(vars, v)
 		}
 	}
 
@@ -438,6 +1077,18 @@ func (d *DashboardDataSource) Read(ctx context.Context, req datasource.ReadReque
 				return
 			}
 
+			// the panel source can be hand-written or imported, e.g. reference the ${DS_PROMETHEUS} import placeholder
+			for _, uid := range panelDatasourceUIDs(column.Source.ValueString()) {
+				if name, ok := datasourceVariable(uid); ok && !names[name] {
+					resp.Diagnostics.AddAttributeWarning(
+						path.Root("layout").AtName("row").AtListIndex(rowIdx).AtName("panel").AtListIndex(columnIdx).AtName("source"),
+						"Unknown Datasource Variable",
+						fmt.Sprintf("The panel queries the data source %s, but the dashboard has no variable named %q. "+
+							"Grafana cannot resolve the data source unless it is an import placeholder.", uid, name),
+					)
+				}
+			}
+
 			height := int(column.Size.Height.ValueInt64())
 			width := int(column.Size.Width.ValueInt64())
 
@@ -484,6 +1135,10 @@ func (d *DashboardDataSource) Read(ctx context.Context, req datasource.ReadReque
 		}
 	}
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	dashboard := &grafana.Board{
 		Title:         data.Title.ValueString(),
 		Editable:      d.Defaults.Editable,
@@ -504,6 +1159,54 @@ func (d *DashboardDataSource) Read(ctx context.Context, req datasource.ReadReque
 		dashboard.UID = data.UID.ValueString()
 	}
 
+	if len(data.Links) > 0 {
+		dashboard.Links = createDashboardLinks(data.Links)
+	}
+
+	builtIn := d.Defaults.BuiltInAnnotations
+	for _, annotations := range data.Annotations {
+		if !annotations.BuiltIn.IsNull() {
+			builtIn = annotations.BuiltIn.ValueBool()
+		}
+	}
+
+	dashboard.Annotations.List = make([]grafana.Annotation, 0)
+
+	if builtIn {
+		dashboard.Annotations.List = append(dashboard.Annotations.List, builtInAnnotation())
+	}
+
+	annotations := createAnnotations(data.Annotations)
+	defined := make(map[string]bool)
+
+	for _, annotation := range annotations {
+		defined[annotation.Name] = true
+	}
+
+	dashboard.Annotations.List = append(dashboard.Annotations.List, annotations...)
+
+	// the annotations of the dashboard take precedence over the provider defaults with the same name
+	for _, annotation := range d.Defaults.Annotations {
+		if !defined[annotation.Name] {
+			dashboard.Annotations.List = append(dashboard.Annotations.List, annotation)
+		}
+	}
+
+	for _, annotation := range dashboard.Annotations.List {
+		if uid, ok := datasourceRefUID(annotation.Datasource); ok {
+			if name, ok := datasourceVariable(uid); ok && !names[name] {
+				resp.Diagnostics.AddError(
+					"Unknown Datasource Variable",
+					fmt.Sprintf("Annotation %s queries the data source %s, but the dashboard has no variable named %q.", annotation.Name, uid, name),
+				)
+			}
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if !data.Editable.IsNull() {
 		dashboard.Editable = data.Editable.ValueBool()
 	}
@@ -543,3 +1246,489 @@ func (d *DashboardDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// builtInAnnotation is the Annotations & Alerts entry that Grafana adds to every dashboard.
+func builtInAnnotation() grafana.Annotation {
+	return grafana.Annotation{
+		BuiltIn: 1,
+		Name:    "Annotations & Alerts",
+		Datasource: map[string]string{
+			"type": "grafana",
+			"uid":  "-- Grafana --",
+		},
+		Enable:    true,
+		Hide:      true,
+		IconColor: "rgba(0, 211, 255, 1)",
+		Target: &grafana.AnnotationTarget{
+			Type:  "dashboard",
+			Limit: 100,
+		},
+		Type: "dashboard",
+	}
+}
+
+// createAnnotations creates the annotations, in the order of the annotation types.
+// The built-in annotation is not included.
+func createAnnotations(annotations []Annotations) []grafana.Annotation {
+	result := make([]grafana.Annotation, 0)
+
+	color := func(value types.String) string {
+		if value.IsNull() {
+			return "red"
+		}
+		return normalizeColor(value.ValueString())
+	}
+
+	enable := func(value types.Bool) bool {
+		if value.IsNull() {
+			return true
+		}
+		return value.ValueBool()
+	}
+
+	for _, options := range annotations {
+		for _, prometheus := range options.Prometheus {
+			step := "60s"
+			if !prometheus.Step.IsNull() {
+				step = prometheus.Step.ValueString()
+			}
+
+			result = append(result, grafana.Annotation{
+				Name: prometheus.Name.ValueString(),
+				Datasource: grafana.Datasource{
+					UID:  datasourceUID(prometheus.DatasourceUid.ValueString()),
+					Type: "prometheus",
+				},
+				Enable:          enable(prometheus.Enable),
+				Hide:            prometheus.Hide.ValueBool(),
+				IconColor:       color(prometheus.Color),
+				Expr:            prometheus.Expr.ValueString(),
+				Step:            step,
+				TitleFormat:     prometheus.TitleFormat.ValueString(),
+				TextFormat:      prometheus.TextFormat.ValueString(),
+				TagKeys:         strings.Join(stringValues(prometheus.TagKeys), ","),
+				UseValueForTime: prometheus.SeriesValueAsTimestamp.ValueBool(),
+			})
+		}
+
+		for _, loki := range options.Loki {
+			result = append(result, grafana.Annotation{
+				Name: loki.Name.ValueString(),
+				Datasource: grafana.Datasource{
+					UID:  datasourceUID(loki.DatasourceUid.ValueString()),
+					Type: "loki",
+				},
+				Enable:      enable(loki.Enable),
+				Hide:        loki.Hide.ValueBool(),
+				IconColor:   color(loki.Color),
+				Expr:        loki.Expr.ValueString(),
+				TitleFormat: loki.TitleFormat.ValueString(),
+				TextFormat:  loki.TextFormat.ValueString(),
+				TagKeys:     strings.Join(stringValues(loki.TagKeys), ","),
+			})
+		}
+
+		for _, g := range options.Grafana {
+			limit := 100
+			if !g.Limit.IsNull() {
+				limit = int(g.Limit.ValueInt64())
+			}
+
+			result = append(result, grafana.Annotation{
+				Name: g.Name.ValueString(),
+				Datasource: map[string]string{
+					"type": "grafana",
+					"uid":  "-- Grafana --",
+				},
+				Enable:    enable(g.Enable),
+				Hide:      g.Hide.ValueBool(),
+				IconColor: color(g.Color),
+				Target: &grafana.AnnotationTarget{
+					Type:     "tags",
+					Tags:     stringValues(g.Tags),
+					MatchAny: g.MatchAny.ValueBool(),
+					Limit:    limit,
+				},
+			})
+		}
+	}
+
+	return result
+}
+
+// createDashboardLinks creates the dashboard links, in the order of the link types.
+func createDashboardLinks(links []DashboardLinks) []grafana.Link {
+	result := make([]grafana.Link, 0)
+
+	for _, link := range links {
+		for _, dashboards := range link.Dashboards {
+			tags := make([]string, len(dashboards.Tags))
+			for i, tag := range dashboards.Tags {
+				tags[i] = tag.ValueString()
+			}
+
+			icon := "external link"
+			asDropdown := dashboards.AsDropdown.ValueBool()
+			keepTime := dashboards.IncludeTimeRange.ValueBool()
+			targetBlank := dashboards.TargetBlank.ValueBool()
+
+			result = append(result, grafana.Link{
+				Title:       dashboards.Title.ValueString(),
+				Type:        "dashboards",
+				Icon:        &icon,
+				Tags:        tags,
+				AsDropdown:  &asDropdown,
+				IncludeVars: dashboards.IncludeVariables.ValueBool(),
+				KeepTime:    &keepTime,
+				TargetBlank: &targetBlank,
+			})
+		}
+
+		for _, l := range link.Link {
+			icon := "external link"
+			if !l.Icon.IsNull() {
+				icon = l.Icon.ValueString()
+			}
+
+			url := l.Url.ValueString()
+			keepTime := l.IncludeTimeRange.ValueBool()
+			targetBlank := l.TargetBlank.ValueBool()
+
+			item := grafana.Link{
+				Title:       l.Title.ValueString(),
+				Type:        "link",
+				Icon:        &icon,
+				URL:         &url,
+				IncludeVars: l.IncludeVariables.ValueBool(),
+				KeepTime:    &keepTime,
+				TargetBlank: &targetBlank,
+			}
+
+			if !l.Tooltip.IsNull() {
+				tooltip := l.Tooltip.ValueString()
+				item.Tooltip = &tooltip
+			}
+
+			result = append(result, item)
+		}
+	}
+
+	return result
+}
+
+// createTemplateVars creates the template variables defined inline, in the order of the variable types.
+func createTemplateVars(variable Variable) []grafana.TemplateVar {
+	vars := make([]grafana.TemplateVar, 0)
+
+	for _, custom := range variable.Custom {
+		opts := make([]grafana.Option, 0)
+		query := ""
+		var current grafana.Current
+
+		if custom.IncludeAll.ValueBool() {
+			opts = append(opts, grafana.Option{Text: "All", Value: "$__all"})
+		}
+
+		texts := make([]string, 0)
+		values := make([]string, 0)
+
+		for _, opt := range custom.Options {
+			opts = append(opts, grafana.Option{
+				Text:     opt.Text.ValueString(),
+				Value:    opt.Value.ValueString(),
+				Selected: opt.Selected.ValueBool(),
+			})
+
+			if query != "" {
+				query = query + ", "
+			}
+
+			query = query + opt.Text.ValueString() + " : " + opt.Value.ValueString()
+
+			if opt.Selected.ValueBool() {
+				texts = append(texts, opt.Text.ValueString())
+				values = append(values, opt.Value.ValueString())
+			}
+		}
+
+		if len(values) > 0 {
+			current.Text = &grafana.StringSliceString{Value: texts, Valid: true}
+
+			if custom.Multi.ValueBool() {
+				current.Value = values
+			} else {
+				current.Value = values[0]
+			}
+		}
+
+		v := grafana.TemplateVar{
+			Type:        "custom",
+			Name:        custom.Name.ValueString(),
+			Label:       custom.Label.ValueString(),
+			Description: custom.Description.ValueString(),
+			Options:     opts,
+			Query:       query,
+			Current:     current,
+			Hide:        variableHide(custom.Hide),
+			Multi:       custom.Multi.ValueBool(),
+			IncludeAll:  custom.IncludeAll.ValueBool(),
+			AllValue:    custom.AllValue.ValueString(),
+		}
+
+		vars = append(vars, v)
+	}
+
+	for _, c := range variable.Constant {
+		v := grafana.TemplateVar{
+			Type:  "constant",
+			Name:  c.Name.ValueString(),
+			Query: c.Value.ValueString(),
+			Hide:  variableHide(c.Hide),
+		}
+
+		vars = append(vars, v)
+	}
+
+	for _, q := range variable.Query {
+		datasourceType := "prometheus"
+		if !q.DatasourceType.IsNull() {
+			datasourceType = q.DatasourceType.ValueString()
+		}
+
+		refresh := variableRefreshes["on-dashboard-load"]
+		if !q.Refresh.IsNull() {
+			refresh = variableRefreshes[q.Refresh.ValueString()]
+		}
+
+		v := grafana.TemplateVar{
+			Type: "query",
+			Name: q.Name.ValueString(),
+			Datasource: grafana.Datasource{
+				UID:  datasourceUID(q.DatasourceUid.ValueString()),
+				Type: datasourceType,
+			},
+			Query:      q.Query.ValueString(),
+			Regex:      q.Regex.ValueString(),
+			Sort:       variableSorts[q.Sort.ValueString()],
+			Refresh:    grafana.BoolInt{Value: &refresh},
+			Multi:      q.Multi.ValueBool(),
+			IncludeAll: q.IncludeAll.ValueBool(),
+			AllValue:   q.AllValue.ValueString(),
+			Current:    variableCurrent(q.Current, q.Multi),
+			Hide:       variableHide(q.Hide),
+		}
+
+		vars = append(vars, v)
+	}
+
+	for _, ds := range variable.Datasource {
+		refresh := variableRefreshes["on-dashboard-load"]
+
+		v := grafana.TemplateVar{
+			Type:       "datasource",
+			Name:       ds.Name.ValueString(),
+			Query:      ds.Type.ValueString(),
+			Regex:      ds.Regex.ValueString(),
+			Refresh:    grafana.BoolInt{Value: &refresh},
+			Multi:      ds.Multi.ValueBool(),
+			IncludeAll: ds.IncludeAll.ValueBool(),
+			Current:    variableCurrent(ds.Current, ds.Multi),
+			Hide:       variableHide(ds.Hide),
+		}
+
+		vars = append(vars, v)
+	}
+
+	for _, interval := range variable.Interval {
+		name := interval.Name.ValueString()
+		autoValue := "$__auto_interval_" + name
+
+		values := make([]string, len(interval.Intervals))
+		for i, value := range interval.Intervals {
+			values[i] = value.ValueString()
+		}
+
+		selected := values[0]
+		if interval.Auto.ValueBool() {
+			selected = "auto"
+		}
+
+		if !interval.Current.IsNull() {
+			selected = interval.Current.ValueString()
+		}
+
+		refresh := variableRefreshes["on-time-range-change"]
+		opts := make([]grafana.Option, 0)
+		var current grafana.Current
+
+		if interval.Auto.ValueBool() {
+			opts = append(opts, grafana.Option{Text: "auto", Value: autoValue, Selected: selected == "auto"})
+
+			if selected == "auto" {
+				current = grafana.Current{
+					Text:  &grafana.StringSliceString{Value: []string{"auto"}, Valid: true},
+					Value: autoValue,
+				}
+			}
+		}
+
+		for _, value := range values {
+			opts = append(opts, grafana.Option{Text: value, Value: value, Selected: selected == value})
+
+			if selected == value {
+				current = grafana.Current{
+					Text:  &grafana.StringSliceString{Value: []string{value}, Valid: true},
+					Value: value,
+				}
+			}
+		}
+
+		v := grafana.TemplateVar{
+			Type:    "interval",
+			Name:    name,
+			Options: opts,
+			Query:   strings.Join(values, ","),
+			Refresh: grafana.BoolInt{Value: &refresh},
+			Current: current,
+			Hide:    variableHide(interval.Hide),
+		}
+
+		if interval.Auto.ValueBool() {
+			autoCount := 30
+			if !interval.AutoCount.IsNull() {
+				autoCount = int(interval.AutoCount.ValueInt64())
+			}
+
+			v.Auto = true
+			v.AutoCount = &autoCount
+			v.AutoMin = "10s"
+
+			if !interval.AutoMin.IsNull() {
+				v.AutoMin = interval.AutoMin.ValueString()
+			}
+		}
+
+		vars = append(vars, v)
+	}
+
+	for _, textbox := range variable.Textbox {
+		value := textbox.DefaultValue.ValueString()
+
+		v := grafana.TemplateVar{
+			Type:  "textbox",
+			Name:  textbox.Name.ValueString(),
+			Query: value,
+			Current: grafana.Current{
+				Text:  &grafana.StringSliceString{Value: []string{value}, Valid: true},
+				Value: value,
+			},
+			Hide: variableHide(textbox.Hide),
+		}
+
+		vars = append(vars, v)
+	}
+
+	for _, adhoc := range variable.AdHoc {
+		datasourceType := "prometheus"
+		if !adhoc.DatasourceType.IsNull() {
+			datasourceType = adhoc.DatasourceType.ValueString()
+		}
+
+		v := grafana.TemplateVar{
+			Type: "adhoc",
+			Name: adhoc.Name.ValueString(),
+			Datasource: grafana.Datasource{
+				UID:  datasourceUID(adhoc.DatasourceUid.ValueString()),
+				Type: datasourceType,
+			},
+			Hide: variableHide(adhoc.Hide),
+		}
+
+		vars = append(vars, v)
+	}
+
+	return vars
+}
+
+// variableHide converts the hide option of a variable to the value expected by Grafana.
+func variableHide(hide types.String) uint8 {
+	switch hide.ValueString() {
+	case "label":
+		return 1
+	case "variable":
+		return 2
+	default:
+		return 0
+	}
+}
+
+// variableCurrent creates the default selection of a variable.
+// Multiple values are validated by the schema, they are only allowed when the variable supports multi-selection.
+func variableCurrent(values []types.String, multi types.Bool) grafana.Current {
+	var current grafana.Current
+
+	if len(values) == 0 {
+		return current
+	}
+
+	selected := make([]string, len(values))
+	for i, value := range values {
+		selected[i] = value.ValueString()
+	}
+
+	current.Text = &grafana.StringSliceString{Value: selected, Valid: true}
+
+	if multi.ValueBool() {
+		current.Value = selected
+	} else {
+		current.Value = selected[0]
+	}
+
+	return current
+}
+
+// datasourceRefUID returns the UID of a data source reference, either created by the provider or parsed from a JSON source.
+func datasourceRefUID(datasource interface{}) (string, bool) {
+	switch ds := datasource.(type) {
+	case grafana.Datasource:
+		return ds.UID, true
+	case map[string]interface{}:
+		uid, ok := ds["uid"].(string)
+		return uid, ok
+	default:
+		return "", false
+	}
+}
+
+// panelDatasourceUIDs returns the UIDs of the data sources used by the panel and its queries.
+func panelDatasourceUIDs(source string) []string {
+	type datasource struct {
+		UID string `json:"uid"`
+	}
+
+	var panel struct {
+		Datasource *datasource `json:"datasource"`
+		Targets    []struct {
+			Datasource *datasource `json:"datasource"`
+		} `json:"targets"`
+	}
+
+	// the datasource can be a plain string in the legacy panels, such panels are skipped
+	if err := json.Unmarshal([]byte(source), &panel); err != nil {
+		return nil
+	}
+
+	uids := make([]string, 0)
+
+	if panel.Datasource != nil {
+		uids = append(uids, panel.Datasource.UID)
+	}
+
+	for _, target := range panel.Targets {
+		if target.Datasource != nil {
+			uids = append(uids, target.Datasource.UID)
+		}
+	}
+
+	return uids
+}
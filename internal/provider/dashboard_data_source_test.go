@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -33,6 +34,53 @@ func TestAccDashboardDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.gdashboard_dashboard.test", "json", testAccDashboardDataSourceProviderCustomDefaultsConfigExpectedJson),
 				),
 			},
+			{
+				Config: testAccDashboardDataSourceProviderAnnotationOverrideConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_dashboard.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_dashboard.test", "json", testAccDashboardDataSourceProviderAnnotationOverrideConfigExpectedJson),
+				),
+			},
+			{
+				Config:      testAccDashboardDataSourceMultipleAnnotationsConfig,
+				ExpectError: regexp.MustCompile("Attribute annotations list must contain at most 1 elements"),
+			},
+			{
+				Config: testAccDashboardDataSourceVariableSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_dashboard.test", "json", testAccDashboardDataSourceVariableSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config:      testAccDashboardDataSourceMultipleCurrentConfig,
+				ExpectError: regexp.MustCompile(`current multiple selected values require multi to be enabled`),
+			},
+			{
+				Config:      testAccDashboardDataSourceUnknownDatasourceVariableConfig,
+				ExpectError: regexp.MustCompile(`the dashboard has no variable named "region"`),
+			},
+			{
+				Config: testAccDashboardDataSourceImportPlaceholderConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_dashboard.test", "title", "Test"),
+				),
+			},
+			{
+				Config:      testAccDashboardDataSourceMultipleLinksConfig,
+				ExpectError: regexp.MustCompile("Attribute links list must contain at most 1 elements"),
+			},
+			{
+				Config:      testAccDashboardDataSourceInvalidIntervalConfig,
+				ExpectError: regexp.MustCompile("must be one of the intervals or auto when auto is enabled"),
+			},
+			{
+				Config:      testAccDashboardDataSourceMultipleSelectedConfig,
+				ExpectError: regexp.MustCompile(`option multiple selected values require multi to be enabled`),
+			},
+			{
+				Config:      testAccDashboardDataSourceDuplicateVariableConfig,
+				ExpectError: regexp.MustCompile(`The variable name "cluster" is already used by another variable`),
+			},
 		},
 	})
 }
@@ -50,6 +98,60 @@ data "gdashboard_dashboard" "test" {
     to   = "now+1h"
   }
 
+  links {
+    dashboards {
+      title              = "Services"
+      tags               = ["service", "jvm"]
+      as_dropdown        = true
+      include_variables  = true
+      include_time_range = true
+    }
+
+    link {
+      title        = "Runbook"
+      url          = "https://runbooks.example.com/jvm"
+      icon         = "doc"
+      tooltip      = "How to handle the alerts"
+      target_blank = true
+    }
+
+    link {
+      title = "Status page"
+      url   = "https://status.example.com"
+    }
+  }
+
+  annotations {
+    prometheus {
+      name                      = "Alerts"
+      datasource_uid            = "$region"
+      expr                      = "ALERTS{alertstate=\"firing\"}"
+      step                      = "30s"
+      title_format              = "{{alertname}}"
+      text_format               = "{{instance}}"
+      tag_keys                  = ["severity", "team"]
+      series_value_as_timestamp = true
+      color                     = "#FF9830"
+      hide                      = true
+    }
+
+    loki {
+      name           = "Errors"
+      datasource_uid = "loki"
+      expr           = "{app=\"api\"} |= \"error\""
+      tag_keys       = ["level"]
+      color          = "semi-dark-red"
+      enable         = false
+    }
+
+    grafana {
+      name      = "Incidents"
+      tags      = ["incident", "jvm"]
+      match_any = true
+      limit     = 20
+    }
+  }
+
   variables {
 	const {
 	  name  = "var"
@@ -71,6 +173,88 @@ data "gdashboard_dashboard" "test" {
 		selected = true
 	  }
     }
+
+    custom {
+      name        = "env"
+      label       = "Environment"
+      description = "The deployment environments"
+      multi       = true
+      include_all = true
+      all_value   = ".*"
+
+      option {
+        text     = "Production"
+        value    = "prod"
+        selected = true
+      }
+
+      option {
+        text     = "Staging"
+        value    = "staging"
+        selected = true
+      }
+
+      option {
+        text  = "Development"
+        value = "dev"
+      }
+    }
+
+    query {
+      name           = "instance"
+      datasource_uid = "prometheus"
+      query          = "label_values(up{job=~\"$job\"}, instance)"
+      regex          = "/(.*):.*/"
+      sort           = "alphabetical-asc"
+      refresh        = "on-time-range-change"
+      multi          = true
+      include_all    = true
+      all_value      = ".*"
+      current        = ["host-1", "host-2"]
+    }
+
+    query {
+      name            = "job"
+      hide            = "variable"
+      datasource_uid  = "loki"
+      datasource_type = "loki"
+      query           = "label_values(job)"
+      current         = ["api"]
+    }
+
+    datasource {
+      name        = "region"
+      type        = "prometheus"
+      regex       = "/prometheus-.*/"
+      multi       = true
+      include_all = true
+      current     = ["prometheus-eu"]
+    }
+
+    interval {
+      name       = "step"
+      intervals  = ["1m", "10m", "1h"]
+      auto       = true
+      auto_count = 20
+      auto_min   = "30s"
+    }
+
+    interval {
+      name      = "window"
+      hide      = "label"
+      intervals = ["5m", "15m"]
+      current   = "15m"
+    }
+
+    textbox {
+      name          = "tenant"
+      default_value = "acme"
+    }
+
+    adhoc {
+      name           = "filters"
+      datasource_uid = "$region"
+    }
   }
 
   layout {
@@ -108,6 +292,14 @@ data "gdashboard_dashboard" "test" {
 		}
 	  	source = "{\"title\": \"Panel 4\"}"
 	  } 
+
+	  panel {
+	  	size = {
+		  height = 3
+		  width  = 3
+		}
+	  	source = "{\"title\": \"Panel 5\", \"targets\": [{\"refId\": \"A\", \"datasource\": {\"type\": \"prometheus\", \"uid\": \"$${region}\"}}]}"
+	  }
 	}
   }
 }
@@ -192,6 +384,32 @@ const testAccDashboardDataSourceConfigExpectedJson = `{
       "transparent": false,
       "type": "",
       "title": "Panel 4"
+    },
+    {
+      "editable": false,
+      "error": false,
+      "gridPos": {
+        "h": 3,
+        "w": 3,
+        "x": 27,
+        "y": 9
+      },
+      "id": 0,
+      "isNew": false,
+      "span": 0,
+      "title": "Panel 5",
+      "transparent": false,
+      "type": "",
+      "targets": [
+        {
+          "datasource": {
+            "type": "prometheus",
+            "uid": "${region}"
+          },
+          "refId": "A"
+        }
+      ],
+      "title": "Panel 5"
     }
   ],
   "templating": {
@@ -230,6 +448,55 @@ const testAccDashboardDataSourceConfigExpectedJson = `{
         "hide": 1,
         "sort": 0
       },
+      {
+        "name": "env",
+        "type": "custom",
+        "datasource": null,
+        "refresh": false,
+        "options": [
+          {
+            "text": "All",
+            "value": "$__all",
+            "selected": false
+          },
+          {
+            "text": "Production",
+            "value": "prod",
+            "selected": true
+          },
+          {
+            "text": "Staging",
+            "value": "staging",
+            "selected": true
+          },
+          {
+            "text": "Development",
+            "value": "dev",
+            "selected": false
+          }
+        ],
+        "includeAll": true,
+        "allFormat": "",
+        "allValue": ".*",
+        "multi": true,
+        "multiFormat": "",
+        "query": "Production : prod, Staging : staging, Development : dev",
+        "regex": "",
+        "current": {
+          "text": [
+            "Production",
+            "Staging"
+          ],
+          "value": [
+            "prod",
+            "staging"
+          ]
+        },
+        "label": "Environment",
+        "description": "The deployment environments",
+        "hide": 0,
+        "sort": 0
+      },
       {
         "name": "var",
         "type": "constant",
@@ -250,17 +517,369 @@ const testAccDashboardDataSourceConfigExpectedJson = `{
         "label": "",
         "hide": 0,
         "sort": 0
+      },
+      {
+        "name": "instance",
+        "type": "query",
+        "datasource": {
+          "id": 0,
+          "orgId": 0,
+          "uid": "prometheus",
+          "name": "",
+          "type": "prometheus",
+          "typeLogoUrl": "",
+          "access": "",
+          "url": "",
+          "isDefault": false,
+          "jsonData": null,
+          "secureJsonData": null
+        },
+        "refresh": 2,
+        "options": null,
+        "includeAll": true,
+        "allFormat": "",
+        "allValue": ".*",
+        "multi": true,
+        "multiFormat": "",
+        "query": "label_values(up{job=~\"$job\"}, instance)",
+        "regex": "/(.*):.*/",
+        "current": {
+          "text": [
+            "host-1",
+            "host-2"
+          ],
+          "value": [
+            "host-1",
+            "host-2"
+          ]
+        },
+        "label": "",
+        "hide": 0,
+        "sort": 1
+      },
+      {
+        "name": "job",
+        "type": "query",
+        "datasource": {
+          "id": 0,
+          "orgId": 0,
+          "uid": "loki",
+          "name": "",
+          "type": "loki",
+          "typeLogoUrl": "",
+          "access": "",
+          "url": "",
+          "isDefault": false,
+          "jsonData": null,
+          "secureJsonData": null
+        },
+        "refresh": 1,
+        "options": null,
+        "includeAll": false,
+        "allFormat": "",
+        "allValue": "",
+        "multi": false,
+        "multiFormat": "",
+        "query": "label_values(job)",
+        "regex": "",
+        "current": {
+          "text": [
+            "api"
+          ],
+          "value": "api"
+        },
+        "label": "",
+        "hide": 2,
+        "sort": 0
+      },
+      {
+        "name": "region",
+        "type": "datasource",
+        "datasource": null,
+        "refresh": 1,
+        "options": null,
+        "includeAll": true,
+        "allFormat": "",
+        "allValue": "",
+        "multi": true,
+        "multiFormat": "",
+        "query": "prometheus",
+        "regex": "/prometheus-.*/",
+        "current": {
+          "text": [
+            "prometheus-eu"
+          ],
+          "value": [
+            "prometheus-eu"
+          ]
+        },
+        "label": "",
+        "hide": 0,
+        "sort": 0
+      },
+      {
+        "name": "step",
+        "type": "interval",
+        "auto": true,
+        "auto_count": 20,
+        "auto_min": "30s",
+        "datasource": null,
+        "refresh": 2,
+        "options": [
+          {
+            "text": "auto",
+            "value": "$__auto_interval_step",
+            "selected": true
+          },
+          {
+            "text": "1m",
+            "value": "1m",
+            "selected": false
+          },
+          {
+            "text": "10m",
+            "value": "10m",
+            "selected": false
+          },
+          {
+            "text": "1h",
+            "value": "1h",
+            "selected": false
+          }
+        ],
+        "includeAll": false,
+        "allFormat": "",
+        "allValue": "",
+        "multi": false,
+        "multiFormat": "",
+        "query": "1m,10m,1h",
+        "regex": "",
+        "current": {
+          "text": [
+            "auto"
+          ],
+          "value": "$__auto_interval_step"
+        },
+        "label": "",
+        "hide": 0,
+        "sort": 0
+      },
+      {
+        "name": "window",
+        "type": "interval",
+        "datasource": null,
+        "refresh": 2,
+        "options": [
+          {
+            "text": "5m",
+            "value": "5m",
+            "selected": false
+          },
+          {
+            "text": "15m",
+            "value": "15m",
+            "selected": true
+          }
+        ],
+        "includeAll": false,
+        "allFormat": "",
+        "allValue": "",
+        "multi": false,
+        "multiFormat": "",
+        "query": "5m,15m",
+        "regex": "",
+        "current": {
+          "text": [
+            "15m"
+          ],
+          "value": "15m"
+        },
+        "label": "",
+        "hide": 1,
+        "sort": 0
+      },
+      {
+        "name": "tenant",
+        "type": "textbox",
+        "datasource": null,
+        "refresh": false,
+        "options": null,
+        "includeAll": false,
+        "allFormat": "",
+        "allValue": "",
+        "multi": false,
+        "multiFormat": "",
+        "query": "acme",
+        "regex": "",
+        "current": {
+          "text": [
+            "acme"
+          ],
+          "value": "acme"
+        },
+        "label": "",
+        "hide": 0,
+        "sort": 0
+      },
+      {
+        "name": "filters",
+        "type": "adhoc",
+        "datasource": {
+          "id": 0,
+          "orgId": 0,
+          "uid": "${region}",
+          "name": "",
+          "type": "prometheus",
+          "typeLogoUrl": "",
+          "access": "",
+          "url": "",
+          "isDefault": false,
+          "jsonData": null,
+          "secureJsonData": null
+        },
+        "refresh": false,
+        "options": null,
+        "includeAll": false,
+        "allFormat": "",
+        "allValue": "",
+        "multi": false,
+        "multiFormat": "",
+        "query": null,
+        "regex": "",
+        "current": {
+          "text": null,
+          "value": null
+        },
+        "label": "",
+        "hide": 0,
+        "sort": 0
       }
     ]
   },
   "annotations": {
-    "list": null
-  },
-  "schemaVersion": 0,
-  "version": 1,
-  "links": null,
-  "time": {
-    "from": "now-1h",
+    "list": [
+      {
+        "builtIn": 1,
+        "name": "Annotations \u0026 Alerts",
+        "datasource": {
+          "type": "grafana",
+          "uid": "-- Grafana --"
+        },
+        "enable": true,
+        "hide": true,
+        "iconColor": "rgba(0, 211, 255, 1)",
+        "target": {
+          "type": "dashboard",
+          "matchAny": false,
+          "limit": 100
+        },
+        "type": "dashboard"
+      },
+      {
+        "name": "Alerts",
+        "datasource": {
+          "id": 0,
+          "orgId": 0,
+          "uid": "${region}",
+          "name": "",
+          "type": "prometheus",
+          "typeLogoUrl": "",
+          "access": "",
+          "url": "",
+          "isDefault": false,
+          "jsonData": null,
+          "secureJsonData": null
+        },
+        "enable": true,
+        "hide": true,
+        "iconColor": "#ff9830",
+        "expr": "ALERTS{alertstate=\"firing\"}",
+        "step": "30s",
+        "textFormat": "{{instance}}",
+        "titleFormat": "{{alertname}}",
+        "tagKeys": "severity,team",
+        "useValueForTime": true
+      },
+      {
+        "name": "Errors",
+        "datasource": {
+          "id": 0,
+          "orgId": 0,
+          "uid": "loki",
+          "name": "",
+          "type": "loki",
+          "typeLogoUrl": "",
+          "access": "",
+          "url": "",
+          "isDefault": false,
+          "jsonData": null,
+          "secureJsonData": null
+        },
+        "enable": false,
+        "hide": false,
+        "iconColor": "semi-dark-red",
+        "expr": "{app=\"api\"} |= \"error\"",
+        "tagKeys": "level"
+      },
+      {
+        "name": "Incidents",
+        "datasource": {
+          "type": "grafana",
+          "uid": "-- Grafana --"
+        },
+        "enable": true,
+        "hide": false,
+        "iconColor": "red",
+        "target": {
+          "type": "tags",
+          "tags": [
+            "incident",
+            "jvm"
+          ],
+          "matchAny": true,
+          "limit": 20
+        }
+      }
+    ]
+  },
+  "schemaVersion": 0,
+  "version": 1,
+  "links": [
+    {
+      "title": "Services",
+      "type": "dashboards",
+      "asDropdown": true,
+      "icon": "external link",
+      "includeVars": true,
+      "keepTime": true,
+      "tags": [
+        "service",
+        "jvm"
+      ],
+      "targetBlank": false
+    },
+    {
+      "title": "Runbook",
+      "type": "link",
+      "icon": "doc",
+      "includeVars": false,
+      "keepTime": false,
+      "targetBlank": true,
+      "tooltip": "How to handle the alerts",
+      "url": "https://runbooks.example.com/jvm"
+    },
+    {
+      "title": "Status page",
+      "type": "link",
+      "icon": "external link",
+      "includeVars": false,
+      "keepTime": false,
+      "targetBlank": false,
+      "url": "https://status.example.com"
+    }
+  ],
+  "time": {
+    "from": "now-1h",
     "to": "now+1h"
   },
   "timepicker": {
@@ -281,6 +900,16 @@ provider "gdashboard" {
         from = "now-12h"
 		to   = "now-3h"
       }
+
+      annotations {
+        built_in = false
+
+        grafana {
+          name  = "Deployments"
+          tags  = ["deployment"]
+          color = "blue"
+        }
+      }
     }
   }
 }
@@ -288,12 +917,133 @@ provider "gdashboard" {
 data "gdashboard_dashboard" "test" {
   title = "Test"
 
+  annotations {
+    prometheus {
+      name           = "Restarts"
+      datasource_uid = "prometheus"
+      expr           = "changes(process_start_time_seconds[5m]) > 0"
+    }
+  }
+
   layout {
 
   }
 }
 `
 
+const testAccDashboardDataSourceProviderAnnotationOverrideConfig = `
+provider "gdashboard" {
+  defaults {
+    dashboard {
+      annotations {
+        grafana {
+          name  = "Deployments"
+          tags  = ["deployment"]
+          color = "blue"
+        }
+      }
+    }
+  }
+}
+
+data "gdashboard_dashboard" "test" {
+  title = "Test"
+
+  annotations {
+    grafana {
+      name  = "Deployments"
+      tags  = ["deployment", "payments"]
+      color = "green"
+    }
+  }
+
+  layout {}
+}
+`
+
+const testAccDashboardDataSourceProviderAnnotationOverrideConfigExpectedJson = `{
+  "slug": "",
+  "title": "Test",
+  "originalTitle": "",
+  "tags": null,
+  "style": "dark",
+  "timezone": "",
+  "editable": true,
+  "hideControls": false,
+  "sharedCrosshair": false,
+  "panels": [],
+  "templating": {
+    "list": []
+  },
+  "annotations": {
+    "list": [
+      {
+        "builtIn": 1,
+        "name": "Annotations \u0026 Alerts",
+        "datasource": {
+          "type": "grafana",
+          "uid": "-- Grafana --"
+        },
+        "enable": true,
+        "hide": true,
+        "iconColor": "rgba(0, 211, 255, 1)",
+        "target": {
+          "type": "dashboard",
+          "matchAny": false,
+          "limit": 100
+        },
+        "type": "dashboard"
+      },
+      {
+        "name": "Deployments",
+        "datasource": {
+          "type": "grafana",
+          "uid": "-- Grafana --"
+        },
+        "enable": true,
+        "hide": false,
+        "iconColor": "green",
+        "target": {
+          "type": "tags",
+          "tags": [
+            "deployment",
+            "payments"
+          ],
+          "matchAny": false,
+          "limit": 100
+        }
+      }
+    ]
+  },
+  "schemaVersion": 0,
+  "version": 1,
+  "links": null,
+  "time": {
+    "from": "now-6h",
+    "to": "now"
+  },
+  "timepicker": {
+    "refresh_intervals": null,
+    "time_options": null
+  }
+}`
+
+const testAccDashboardDataSourceMultipleAnnotationsConfig = `
+data "gdashboard_dashboard" "test" {
+  title = "Test"
+
+  annotations {
+    built_in = false
+  }
+
+  annotations {
+    built_in = true
+  }
+
+  layout {}
+}
+`
+
 const testAccDashboardDataSourceProviderCustomDefaultsConfigExpectedJson = `{
   "slug": "",
   "title": "Test",
@@ -309,7 +1059,47 @@ const testAccDashboardDataSourceProviderCustomDefaultsConfigExpectedJson = `{
     "list": []
   },
   "annotations": {
-    "list": null
+    "list": [
+      {
+        "name": "Restarts",
+        "datasource": {
+          "id": 0,
+          "orgId": 0,
+          "uid": "prometheus",
+          "name": "",
+          "type": "prometheus",
+          "typeLogoUrl": "",
+          "access": "",
+          "url": "",
+          "isDefault": false,
+          "jsonData": null,
+          "secureJsonData": null
+        },
+        "enable": true,
+        "hide": false,
+        "iconColor": "red",
+        "expr": "changes(process_start_time_seconds[5m]) \u003e 0",
+        "step": "60s"
+      },
+      {
+        "name": "Deployments",
+        "datasource": {
+          "type": "grafana",
+          "uid": "-- Grafana --"
+        },
+        "enable": true,
+        "hide": false,
+        "iconColor": "blue",
+        "target": {
+          "type": "tags",
+          "tags": [
+            "deployment"
+          ],
+          "matchAny": false,
+          "limit": 100
+        }
+      }
+    ]
   },
   "schemaVersion": 0,
   "version": 1,
@@ -350,7 +1140,326 @@ const testAccDashboardDataSourceProviderDefaultsConfigExpectedJson = `{
     "list": []
   },
   "annotations": {
-    "list": null
+    "list": [
+      {
+        "builtIn": 1,
+        "name": "Annotations \u0026 Alerts",
+        "datasource": {
+          "type": "grafana",
+          "uid": "-- Grafana --"
+        },
+        "enable": true,
+        "hide": true,
+        "iconColor": "rgba(0, 211, 255, 1)",
+        "target": {
+          "type": "dashboard",
+          "matchAny": false,
+          "limit": 100
+        },
+        "type": "dashboard"
+      }
+    ]
+  },
+  "schemaVersion": 0,
+  "version": 1,
+  "links": null,
+  "time": {
+    "from": "now-6h",
+    "to": "now"
+  },
+  "timepicker": {
+    "refresh_intervals": null,
+    "time_options": null
+  }
+}`
+
+const testAccDashboardDataSourceMultipleCurrentConfig = `
+data "gdashboard_dashboard" "test" {
+  title = "Test"
+
+  variables {
+    query {
+      name           = "instance"
+      datasource_uid = "prometheus"
+      query          = "label_values(instance)"
+      current        = ["host-1", "host-2"]
+    }
+  }
+
+  layout {}
+}
+`
+
+const testAccDashboardDataSourceUnknownDatasourceVariableConfig = `
+data "gdashboard_dashboard" "test" {
+  title = "Test"
+
+  annotations {
+    prometheus {
+      name           = "Restarts"
+      datasource_uid = "$${region}"
+      expr           = "changes(process_start_time_seconds[5m]) > 0"
+    }
+  }
+
+  layout {}
+}
+`
+
+const testAccDashboardDataSourceImportPlaceholderConfig = `
+data "gdashboard_dashboard" "test" {
+  title = "Test"
+
+  layout {
+    row {
+      panel {
+        size = {
+          height = 8
+          width  = 10
+        }
+        source = "{\"title\": \"Panel\", \"targets\": [{\"refId\": \"A\", \"datasource\": {\"type\": \"prometheus\", \"uid\": \"$${DS_PROMETHEUS}\"}}]}"
+      }
+    }
+  }
+}
+`
+
+const testAccDashboardDataSourceMultipleLinksConfig = `
+data "gdashboard_dashboard" "test" {
+  title = "Test"
+
+  links {
+    link {
+      title = "Runbook"
+      url   = "https://runbooks.example.com/jvm"
+    }
+  }
+
+  links {
+    link {
+      title = "Status page"
+      url   = "https://status.example.com"
+    }
+  }
+
+  layout {}
+}
+`
+
+const testAccDashboardDataSourceInvalidIntervalConfig = `
+data "gdashboard_dashboard" "test" {
+  title = "Test"
+
+  variables {
+    interval {
+      name      = "step"
+      intervals = ["1m", "10m"]
+      current   = "auto"
+    }
+  }
+
+  layout {}
+}
+`
+
+const testAccDashboardDataSourceMultipleSelectedConfig = `
+data "gdashboard_dashboard" "test" {
+  title = "Test"
+
+  variables {
+    custom {
+      name = "env"
+
+      option {
+        text     = "Production"
+        value    = "prod"
+        selected = true
+      }
+
+      option {
+        text     = "Staging"
+        value    = "staging"
+        selected = true
+      }
+    }
+  }
+
+  layout {}
+}
+`
+
+const testAccDashboardDataSourceDuplicateVariableConfig = `
+data "gdashboard_variable" "cluster" {
+  const {
+    name  = "cluster"
+    value = "eu-west-1"
+  }
+}
+
+data "gdashboard_dashboard" "test" {
+  title = "Test"
+
+  variables {
+    source = data.gdashboard_variable.cluster.json
+  }
+
+  variables {
+    textbox {
+      name = "cluster"
+    }
+  }
+
+  layout {}
+}
+`
+
+const testAccDashboardDataSourceVariableSourceConfig = `
+data "gdashboard_variable" "cluster" {
+  const {
+    name  = "cluster"
+    value = "eu-west-1"
+    hide  = "variable"
+  }
+}
+
+data "gdashboard_variable" "datasource" {
+  datasource {
+    name = "datasource"
+    type = "prometheus"
+  }
+}
+
+data "gdashboard_dashboard" "test" {
+  title = "Test"
+
+  variables {
+    source = data.gdashboard_variable.datasource.json
+  }
+
+  variables {
+    source = data.gdashboard_variable.cluster.json
+
+    query {
+      name           = "namespace"
+      datasource_uid = "$datasource"
+      query          = "label_values(kube_pod_info{cluster=\"$cluster\"}, namespace)"
+    }
+  }
+
+  layout {}
+}
+`
+
+const testAccDashboardDataSourceVariableSourceConfigExpectedJson = `{
+  "slug": "",
+  "title": "Test",
+  "originalTitle": "",
+  "tags": null,
+  "style": "dark",
+  "timezone": "",
+  "editable": true,
+  "hideControls": false,
+  "sharedCrosshair": false,
+  "panels": [],
+  "templating": {
+    "list": [
+      {
+        "name": "datasource",
+        "type": "datasource",
+        "datasource": null,
+        "refresh": 1,
+        "options": null,
+        "includeAll": false,
+        "allFormat": "",
+        "allValue": "",
+        "multi": false,
+        "multiFormat": "",
+        "query": "prometheus",
+        "regex": "",
+        "current": {
+          "text": null,
+          "value": null
+        },
+        "label": "",
+        "hide": 0,
+        "sort": 0
+      },
+      {
+        "name": "cluster",
+        "type": "constant",
+        "datasource": null,
+        "refresh": false,
+        "options": null,
+        "includeAll": false,
+        "allFormat": "",
+        "allValue": "",
+        "multi": false,
+        "multiFormat": "",
+        "query": "eu-west-1",
+        "regex": "",
+        "current": {
+          "text": null,
+          "value": null
+        },
+        "label": "",
+        "hide": 2,
+        "sort": 0
+      },
+      {
+        "name": "namespace",
+        "type": "query",
+        "datasource": {
+          "id": 0,
+          "orgId": 0,
+          "uid": "${datasource}",
+          "name": "",
+          "type": "prometheus",
+          "typeLogoUrl": "",
+          "access": "",
+          "url": "",
+          "isDefault": false,
+          "jsonData": null,
+          "secureJsonData": null
+        },
+        "refresh": 1,
+        "options": null,
+        "includeAll": false,
+        "allFormat": "",
+        "allValue": "",
+        "multi": false,
+        "multiFormat": "",
+        "query": "label_values(kube_pod_info{cluster=\"$cluster\"}, namespace)",
+        "regex": "",
+        "current": {
+          "text": null,
+          "value": null
+        },
+        "label": "",
+        "hide": 0,
+        "sort": 0
+      }
+    ]
+  },
+  "annotations": {
+    "list": [
+      {
+        "builtIn": 1,
+        "name": "Annotations \u0026 Alerts",
+        "datasource": {
+          "type": "grafana",
+          "uid": "-- Grafana --"
+        },
+        "enable": true,
+        "hide": true,
+        "iconColor": "rgba(0, 211, 255, 1)",
+        "target": {
+          "type": "dashboard",
+          "matchAny": false,
+          "limit": 100
+        },
+        "type": "dashboard"
+      }
+    ]
   },
   "schemaVersion": 0,
   "version": 1,
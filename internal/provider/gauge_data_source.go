@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -17,6 +18,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ datasource.DataSource = &GaugeDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &GaugeDataSource{}
 
 func NewGaugeDataSource() datasource.DataSource {
 	return &GaugeDataSource{}
@@ -28,34 +30,46 @@ type GaugeDataSource struct {
 }
 
 type GaugeDefaults struct {
-	Field FieldDefaults
-	Graph GaugeGraphDefault
+	Field        FieldDefaults
+	Graph        GaugeGraphDefault
+	QueryOptions QueryOptionsDefaults
+	Queries      QueryDefaults
+	Panel        PanelDefaults
 }
 
 type GaugeGraphDefault struct {
 	Orientation          string
 	ShowThresholdLabels  bool
 	ShowThresholdMarkers bool
+	Sizing               string
+	MinVizWidth          int
+	MinVizHeight         int
 	TextSize             TextSizeDefaults
 	ReduceOptions        ReduceOptionDefaults
 }
 
 // GaugeDataSourceModel describes the data source data model.
 type GaugeDataSourceModel struct {
-	Id          types.String           `tfsdk:"id"`
-	Json        types.String           `tfsdk:"json"`
-	Title       types.String           `tfsdk:"title"`
-	Description types.String           `tfsdk:"description"`
-	Queries     []Query                `tfsdk:"queries"`
-	Field       []FieldOptions         `tfsdk:"field"`
-	Graph       []GaugeOptions         `tfsdk:"graph"`
-	Overrides   []FieldOverrideOptions `tfsdk:"overrides"`
+	Id              types.String                         `tfsdk:"id"`
+	Json            types.String                         `tfsdk:"json"`
+	Title           types.String                         `tfsdk:"title"`
+	Description     types.String                         `tfsdk:"description"`
+	Queries         []Query                              `tfsdk:"queries"`
+	Field           []FieldOptions                       `tfsdk:"field"`
+	Graph           []GaugeOptions                       `tfsdk:"graph"`
+	Overrides       []FieldOverrideOptions[FieldOptions] `tfsdk:"overrides"`
+	QueryOptions    []QueryOptions                       `tfsdk:"query_options"`
+	Transformations []Transformations                    `tfsdk:"transformations"`
+	Links           []PanelLinkOptions                   `tfsdk:"links"`
 }
 
 type GaugeOptions struct {
 	Orientation          types.String      `tfsdk:"orientation"`
 	ShowThresholdLabels  types.Bool        `tfsdk:"show_threshold_labels"`
 	ShowThresholdMarkers types.Bool        `tfsdk:"show_threshold_markers"`
+	Sizing               types.String      `tfsdk:"sizing"`
+	MinVizWidth          types.Int64       `tfsdk:"min_viz_width"`
+	MinVizHeight         types.Int64       `tfsdk:"min_viz_height"`
 	TextSize             []TextSizeOptions `tfsdk:"text_size"`
 	ReduceOptions        []ReduceOptions   `tfsdk:"options"`
 }
@@ -89,6 +103,30 @@ func gaugeGraphBlock() schema.Block {
 					Optional:    true,
 					Description: "Whether to render the thresholds as an outer bar or not.",
 				},
+				"sizing": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The sizing of the gauge. The min and max sizes apply to the manual sizing only. The choices are: auto, manual.",
+					MarkdownDescription: "The sizing of the gauge. The min and max sizes apply to the `manual` sizing only. The choices are: `auto`, `manual`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("auto", "manual"),
+					},
+				},
+				"min_viz_width": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The minimum width of the gauge in pixels. Must be between 0 and 600 (inclusive).",
+					MarkdownDescription: "The minimum width of the gauge in pixels. Must be between `0` and `600` (inclusive).",
+					Validators: []validator.Int64{
+						int64validator.Between(0, 600),
+					},
+				},
+				"min_viz_height": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The minimum height of the gauge in pixels. Must be between 0 and 600 (inclusive).",
+					MarkdownDescription: "The minimum height of the gauge in pixels. Must be between `0` and `600` (inclusive).",
+					Validators: []validator.Int64{
+						int64validator.Between(0, 600),
+					},
+				},
 			},
 		},
 		Validators: []validator.List{
@@ -104,10 +142,13 @@ func (d *GaugeDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 		MarkdownDescription: "Gauge panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/gauge/). for more details",
 
 		Blocks: map[string]schema.Block{
-			"queries":   queryBlock(),
-			"field":     fieldBlock(),
-			"graph":     gaugeGraphBlock(),
-			"overrides": fieldOverrideBlock(),
+			"queries":         queryBlock(),
+			"field":           fieldBlock(),
+			"graph":           gaugeGraphBlock(),
+			"overrides":       fieldOverrideBlock(fieldBlock()),
+			"query_options":   queryOptionsBlock(),
+			"transformations": transformationsBlock(),
+			"links":           panelLinksBlock(),
 		},
 
 		Attributes: map[string]schema.Attribute{
@@ -137,6 +178,16 @@ func (d *GaugeDataSource) Configure(_ context.Context, req datasource.ConfigureR
 	d.Defaults = defaults.Gauge
 }
 
+func (d *GaugeDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data GaugeDataSourceModel
+
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		return
+	}
+
+	validatePanelConfig(data.Queries, data.Overrides, createOverrideProperties, &resp.Diagnostics)
+}
+
 func (d *GaugeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data GaugeDataSourceModel
 
@@ -147,13 +198,25 @@ func (d *GaugeDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	targets := createTargets(data.Queries)
+	targets := createTargets(d.Defaults.Queries, data.Queries, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	fieldConfig := createFieldConfig(d.Defaults.Field, data.Field)
 
+	// the options keep pointers, hence the defaults are copied so the panels never share them
+	showThresholdLabels := d.Defaults.Graph.ShowThresholdLabels
+	showThresholdMarkers := d.Defaults.Graph.ShowThresholdMarkers
+	minVizWidth := d.Defaults.Graph.MinVizWidth
+	minVizHeight := d.Defaults.Graph.MinVizHeight
+
 	options := grafana.Options{
 		Orientation:          d.Defaults.Graph.Orientation,
-		ShowThresholdLabels:  &d.Defaults.Graph.ShowThresholdLabels,
-		ShowThresholdMarkers: &d.Defaults.Graph.ShowThresholdMarkers,
+		ShowThresholdLabels:  &showThresholdLabels,
+		ShowThresholdMarkers: &showThresholdMarkers,
+		Sizing:               d.Defaults.Graph.Sizing,
+		MinVizWidth:          &minVizWidth,
+		MinVizHeight:         &minVizHeight,
 		ReduceOptions: grafana.ReduceOptions{
 			Values: d.Defaults.Graph.ReduceOptions.Values,
 			Fields: d.Defaults.Graph.ReduceOptions.Fields,
@@ -181,6 +244,20 @@ func (d *GaugeDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			options.ShowThresholdMarkers = &show
 		}
 
+		if !graph.Sizing.IsNull() {
+			options.Sizing = graph.Sizing.ValueString()
+		}
+
+		if !graph.MinVizWidth.IsNull() {
+			width := int(graph.MinVizWidth.ValueInt64())
+			options.MinVizWidth = &width
+		}
+
+		if !graph.MinVizHeight.IsNull() {
+			height := int(graph.MinVizHeight.ValueInt64())
+			options.MinVizHeight = &height
+		}
+
 		updateTextSize(&options.TextSize, graph.TextSize)
 		updateReduceOptions(&options.ReduceOptions, graph.ReduceOptions)
 	}
@@ -198,7 +275,7 @@ func (d *GaugeDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 			Options: options,
 			FieldConfig: grafana.FieldConfig{
 				Defaults:  fieldConfig,
-				Overrides: createOverrides(data.Overrides),
+				Overrides: createOverrides(data.Overrides, createOverrideProperties),
 			},
 		},
 	}
@@ -208,6 +285,15 @@ func (d *GaugeDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		panel.CommonPanel.Description = &description
 	}
 
+	updateQueryOptions(&panel.CommonPanel, d.Defaults.QueryOptions, data.QueryOptions)
+
+	panel.CommonPanel.Transformations = createTransformations(data.Transformations, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	panel.CommonPanel.Links = createPanelLinks(d.Defaults.Panel, data.Links)
+
 	jsonData, err := json.MarshalIndent(panel, "", "  ")
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
@@ -46,6 +46,9 @@ data "gdashboard_gauge" "test" {
     orientation 		   = "vertical"
 	show_threshold_labels  = true
     show_threshold_markers = false
+    sizing                 = "manual"
+    min_viz_width          = 100
+    min_viz_height         = 120
 
     options {
       values      = true
@@ -95,6 +98,25 @@ data "gdashboard_gauge" "test" {
       instant      = true
     }
   }
+
+  links {
+    title        = "Runbook"
+    url          = "https://runbooks.example.com/jvm-heap"
+    target_blank = true
+  }
+
+  links {
+    title              = "Heap dashboard"
+    url                = "/d/jvm-heap?orgId=1"
+    include_time_range = true
+    include_variables  = true
+  }
+
+  links {
+    title              = "Heap panel"
+    url                = "/d/jvm-heap#view-panel-2"
+    include_time_range = true
+  }
 }
 `
 
@@ -104,6 +126,21 @@ const testAccGaugeDataSourceConfigExpectedJson = `{
   "gridPos": {},
   "id": 0,
   "isNew": true,
+  "links": [
+    {
+      "title": "Runbook",
+      "url": "https://runbooks.example.com/jvm-heap",
+      "targetBlank": true
+    },
+    {
+      "title": "Heap dashboard",
+      "url": "/d/jvm-heap?orgId=1\u0026${__url_time_range}\u0026${__all_variables}"
+    },
+    {
+      "title": "Heap panel",
+      "url": "/d/jvm-heap?${__url_time_range}#view-panel-2"
+    }
+  ],
   "span": 12,
   "title": "Test",
   "description": "Gauge description",
@@ -120,6 +157,9 @@ const testAccGaugeDataSourceConfigExpectedJson = `{
     "mode": "",
     "showThresholdLabels": true,
     "showThresholdMarkers": false,
+    "sizing": "manual",
+    "minVizWidth": 100,
+    "minVizHeight": 120,
     "text": {
       "titleSize": 10,
       "valueSize": 15
@@ -134,7 +174,7 @@ const testAccGaugeDataSourceConfigExpectedJson = `{
   },
   "targets": [
     {
-      "refId": "",
+      "refId": "A",
       "datasource": {
         "id": 0,
         "orgId": 0,
@@ -253,6 +293,8 @@ provider "gdashboard" {
         orientation            = "vertical"
         show_threshold_labels  = true
         show_threshold_markers = false
+        sizing                 = "manual"
+        min_viz_width          = 50
 
         options {
           values      = true
@@ -266,11 +308,25 @@ provider "gdashboard" {
 		}
       }
 	}
+
+    panel {
+      links {
+        title        = "Alert rules"
+        url          = "/alerting/list"
+        target_blank = true
+      }
+    }
   }
 }
 
 data "gdashboard_gauge" "test" {
   title = "Test"
+
+  links {
+    title             = "Source code"
+    url               = "https://github.com/example/service"
+    include_variables = true
+  }
 }
 `
 
@@ -280,6 +336,17 @@ const testAccGaugeDataSourceProviderCustomDefaultsConfigExpectedJson = `{
   "gridPos": {},
   "id": 0,
   "isNew": true,
+  "links": [
+    {
+      "title": "Source code",
+      "url": "https://github.com/example/service?${__all_variables}"
+    },
+    {
+      "title": "Alert rules",
+      "url": "/alerting/list",
+      "targetBlank": true
+    }
+  ],
   "span": 12,
   "title": "Test",
   "transparent": false,
@@ -295,6 +362,9 @@ const testAccGaugeDataSourceProviderCustomDefaultsConfigExpectedJson = `{
     "mode": "",
     "showThresholdLabels": true,
     "showThresholdMarkers": false,
+    "sizing": "manual",
+    "minVizWidth": 50,
+    "minVizHeight": 75,
     "text": {
       "titleSize": 10,
       "valueSize": 15
@@ -396,6 +466,9 @@ const testAccGaugeDataSourceProviderDefaultsConfigExpectedJson = `{
     "mode": "",
     "showThresholdLabels": false,
     "showThresholdMarkers": true,
+    "sizing": "auto",
+    "minVizWidth": 75,
+    "minVizHeight": 75,
     "text": {},
     "reduceOptions": {
       "values": false,
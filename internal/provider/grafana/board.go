@@ -50,6 +50,7 @@ type (
 		Type        string      `json:"type"`
 		Auto        bool        `json:"auto,omitempty"`
 		AutoCount   *int        `json:"auto_count,omitempty"`
+		AutoMin     string      `json:"auto_min,omitempty"`
 		Datasource  interface{} `json:"datasource"`
 		Refresh     BoolInt     `json:"refresh"`
 		Options     []Option    `json:"options"`
@@ -62,6 +63,7 @@ type (
 		Regex       string      `json:"regex"`
 		Current     Current     `json:"current"`
 		Label       string      `json:"label"`
+		Description string      `json:"description,omitempty"`
 		Hide        uint8       `json:"hide"`
 		Sort        int         `json:"sort"`
 	}
@@ -78,23 +80,34 @@ type (
 		Value interface{}        `json:"value"` // TODO select more precise type
 	}
 	Annotation struct {
-		Name        string      `json:"name"`
-		Datasource  interface{} `json:"datasource"`
-		ShowLine    bool        `json:"showLine"`
-		IconColor   string      `json:"iconColor"`
-		LineColor   string      `json:"lineColor"`
-		IconSize    uint        `json:"iconSize"`
-		Enable      bool        `json:"enable"`
-		Query       string      `json:"query"`
-		Expr        string      `json:"expr"`
-		Step        string      `json:"step"`
-		TextField   string      `json:"textField"`
-		TextFormat  string      `json:"textFormat"`
-		TitleFormat string      `json:"titleFormat"`
-		TagsField   string      `json:"tagsField"`
-		Tags        []string    `json:"tags"`
-		TagKeys     string      `json:"tagKeys"`
-		Type        string      `json:"type"`
+		BuiltIn         int               `json:"builtIn,omitempty"`
+		Name            string            `json:"name"`
+		Datasource      interface{}       `json:"datasource"`
+		Enable          bool              `json:"enable"`
+		Hide            bool              `json:"hide"`
+		ShowLine        bool              `json:"showLine,omitempty"`
+		IconColor       string            `json:"iconColor"`
+		LineColor       string            `json:"lineColor,omitempty"`
+		IconSize        uint              `json:"iconSize,omitempty"`
+		Query           string            `json:"query,omitempty"`
+		Expr            string            `json:"expr,omitempty"`
+		Step            string            `json:"step,omitempty"`
+		TextField       string            `json:"textField,omitempty"`
+		TextFormat      string            `json:"textFormat,omitempty"`
+		TitleFormat     string            `json:"titleFormat,omitempty"`
+		TagsField       string            `json:"tagsField,omitempty"`
+		Tags            []string          `json:"tags,omitempty"`
+		TagKeys         string            `json:"tagKeys,omitempty"`
+		UseValueForTime bool              `json:"useValueForTime,omitempty"`
+		Target          *AnnotationTarget `json:"target,omitempty"`
+		Type            string            `json:"type,omitempty"`
+	}
+	// AnnotationTarget defines the annotations to fetch from Grafana
+	AnnotationTarget struct {
+		Type     string   `json:"type"`
+		Tags     []string `json:"tags,omitempty"`
+		MatchAny bool     `json:"matchAny"`
+		Limit    int      `json:"limit"`
 	}
 	// Link represents link to another dashboard or external weblink
 	Link struct {
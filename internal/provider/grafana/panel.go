@@ -62,11 +62,11 @@ type (
 		HideTimeOverride *bool       `json:"hideTimeOverride,omitempty"`
 		ID               uint        `json:"id"`
 		IsNew            bool        `json:"isNew"`
-		//Links            []Link      `json:"links,omitempty"`    // general
-		MinSpan  *float32  `json:"minSpan,omitempty"`  // templating options
-		OfType   panelType `json:"-"`                  // it required for defining type of the panel
-		Renderer *string   `json:"renderer,omitempty"` // display styles
-		Repeat   *string   `json:"repeat,omitempty"`   // templating options
+		Links            []DataLink  `json:"links,omitempty"`    // general
+		MinSpan          *float32    `json:"minSpan,omitempty"`  // templating options
+		OfType           panelType   `json:"-"`                  // it required for defining type of the panel
+		Renderer         *string     `json:"renderer,omitempty"` // display styles
+		Repeat           *string     `json:"repeat,omitempty"`   // templating options
 		// RepeatIteration *int64   `json:"repeatIteration,omitempty"`
 		RepeatPanelID *uint `json:"repeatPanelId,omitempty"`
 		ScopedVars    map[string]struct {
@@ -80,6 +80,14 @@ type (
 		Transparent bool    `json:"transparent"`
 		Type        string  `json:"type"`
 		Alert       *Alert  `json:"alert,omitempty"`
+		// query options
+		MaxDataPoints *int    `json:"maxDataPoints,omitempty"`
+		Interval      *string `json:"interval,omitempty"`
+		TimeFrom      *string `json:"timeFrom,omitempty"`
+		TimeShift     *string `json:"timeShift,omitempty"`
+		CacheTimeout  *string `json:"cacheTimeout,omitempty"`
+		// transformations
+		Transformations []Transformation `json:"transformations,omitempty"`
 	}
 	AlertEvaluator struct {
 		Params []float64 `json:"params,omitempty"`
@@ -138,8 +146,6 @@ type (
 		SteppedLine     bool             `json:"steppedLine"`
 		Targets         []Target         `json:"targets,omitempty"`
 		Thresholds      []Threshold      `json:"thresholds,omitempty"`
-		TimeFrom        *string          `json:"timeFrom,omitempty"`
-		TimeShift       *string          `json:"timeShift,omitempty"`
 		Tooltip         Tooltip          `json:"tooltip"`
 		XAxis           bool             `json:"x-axis,omitempty"`
 		YAxis           bool             `json:"y-axis,omitempty"`
@@ -174,6 +180,19 @@ type (
 		// gauge specific
 		ShowThresholdLabels  *bool `json:"showThresholdLabels,omitempty"`
 		ShowThresholdMarkers *bool `json:"showThresholdMarkers,omitempty"`
+		// stat specific
+		ShowPercentChange      *bool  `json:"showPercentChange,omitempty"`
+		PercentChangeColorMode string `json:"percentChangeColorMode,omitempty"`
+		WideLayout             *bool  `json:"wideLayout,omitempty"`
+		// bar gauge specific
+		ValueMode     string `json:"valueMode,omitempty"`
+		NamePlacement string `json:"namePlacement,omitempty"`
+		ShowUnfilled  *bool  `json:"showUnfilled,omitempty"`
+		MaxVizHeight  *int   `json:"maxVizHeight,omitempty"`
+		// gauge and bar gauge specific
+		Sizing       string `json:"sizing,omitempty"`
+		MinVizWidth  *int   `json:"minVizWidth,omitempty"`
+		MinVizHeight *int   `json:"minVizHeight,omitempty"`
 		// etc
 		TextSize      TextSize      `json:"text"`
 		ReduceOptions ReduceOptions `json:"reduceOptions"`
@@ -367,59 +386,94 @@ type (
 		Calcs       []string `json:"calcs"`
 		DisplayMode string   `json:"displayMode"`
 		Placement   string   `json:"placement"`
+		ShowLegend  *bool    `json:"showLegend,omitempty"`
+		Width       *int     `json:"width,omitempty"`
+		SortBy      string   `json:"sortBy,omitempty"`
+		SortDesc    bool     `json:"sortDesc,omitempty"`
 	}
 	TimeseriesTooltipOptions struct {
-		Mode string `json:"mode"`
+		Mode      string `json:"mode"`
+		Sort      string `json:"sort,omitempty"`
+		MaxWidth  *int   `json:"maxWidth,omitempty"`
+		MaxHeight *int   `json:"maxHeight,omitempty"`
+		HideZeros bool   `json:"hideZeros,omitempty"`
 	}
 	FieldConfigDefaults struct {
-		Unit       string            `json:"unit"`
-		Decimals   *int              `json:"decimals,omitempty"`
-		Min        *float64          `json:"min,omitempty"`
-		Max        *float64          `json:"max,omitempty"`
-		NoValue    *float64          `json:"noValue,omitempty"`
-		Color      FieldConfigColor  `json:"color"`
-		Thresholds Thresholds        `json:"thresholds"`
-		Custom     FieldConfigCustom `json:"custom"`
-		Mappings   []FieldMapping    `json:"mappings,omitempty"`
-		//Links      []Link            `json:"links,omitempty"`
+		Unit        string            `json:"unit"`
+		Decimals    *int              `json:"decimals,omitempty"`
+		Min         *float64          `json:"min,omitempty"`
+		Max         *float64          `json:"max,omitempty"`
+		NoValue     *float64          `json:"noValue,omitempty"`
+		DisplayName string            `json:"displayName,omitempty"`
+		Description string            `json:"description,omitempty"`
+		Filterable  *bool             `json:"filterable,omitempty"`
+		FieldMinMax *bool             `json:"fieldMinMax,omitempty"`
+		Color       FieldConfigColor  `json:"color"`
+		Thresholds  Thresholds        `json:"thresholds"`
+		Custom      FieldConfigCustom `json:"custom"`
+		Mappings    []FieldMapping    `json:"mappings,omitempty"`
+		Links       []DataLink        `json:"links,omitempty"`
 	}
 	FieldMapping struct {
 		Type    string                 `json:"type"`
 		Options map[string]interface{} `json:"options"`
 	}
+	DataLink struct {
+		Title       string            `json:"title"`
+		URL         string            `json:"url"`
+		TargetBlank bool              `json:"targetBlank,omitempty"`
+		Internal    *DataLinkInternal `json:"internal,omitempty"`
+	}
+	DataLinkInternal struct {
+		DatasourceUID  string                 `json:"datasourceUid"`
+		DatasourceName string                 `json:"datasourceName,omitempty"`
+		Query          map[string]interface{} `json:"query"`
+	}
+	Transformation struct {
+		ID      string                 `json:"id"`
+		Options map[string]interface{} `json:"options"`
+	}
 	FieldConfigCustom struct {
-		AxisLabel         string `json:"axisLabel,omitempty"`
-		AxisPlacement     string `json:"axisPlacement"`
-		AxisSoftMin       *int   `json:"axisSoftMin,omitempty"`
-		AxisSoftMax       *int   `json:"axisSoftMax,omitempty"`
-		BarAlignment      int    `json:"barAlignment"`
-		DrawStyle         string `json:"drawStyle"`
-		FillOpacity       int    `json:"fillOpacity"`
-		GradientMode      string `json:"gradientMode"`
-		LineInterpolation string `json:"lineInterpolation"`
-		LineWidth         int    `json:"lineWidth"`
-		PointSize         int    `json:"pointSize"`
-		ShowPoints        string `json:"showPoints"`
-		SpanNulls         bool   `json:"spanNulls"`
-		HideFrom          struct {
-			Legend  bool `json:"legend"`
-			Tooltip bool `json:"tooltip"`
-			Viz     bool `json:"viz"`
-		} `json:"hideFrom"`
-		LineStyle struct {
-			Fill string `json:"fill"`
-		} `json:"lineStyle"`
-		ScaleDistribution struct {
-			Type string `json:"type"`
-			Log  int    `json:"log,omitempty"`
-		} `json:"scaleDistribution"`
-		Stacking struct {
+		AxisLabel         string            `json:"axisLabel,omitempty"`
+		AxisPlacement     string            `json:"axisPlacement"`
+		AxisSoftMin       *int              `json:"axisSoftMin,omitempty"`
+		AxisSoftMax       *int              `json:"axisSoftMax,omitempty"`
+		BarAlignment      int               `json:"barAlignment"`
+		BarWidthFactor    *float64          `json:"barWidthFactor,omitempty"`
+		BarMaxWidth       *int              `json:"barMaxWidth,omitempty"`
+		DrawStyle         string            `json:"drawStyle"`
+		FillOpacity       int               `json:"fillOpacity"`
+		GradientMode      string            `json:"gradientMode"`
+		LineInterpolation string            `json:"lineInterpolation"`
+		LineWidth         int               `json:"lineWidth"`
+		PointSize         int               `json:"pointSize"`
+		ShowPoints        string            `json:"showPoints"`
+		SpanNulls         bool              `json:"spanNulls"`
+		InsertNulls       *int              `json:"insertNulls,omitempty"`
+		Transform         string            `json:"transform,omitempty"`
+		HideFrom          HideFrom          `json:"hideFrom"`
+		LineStyle         LineStyle         `json:"lineStyle"`
+		ScaleDistribution ScaleDistribution `json:"scaleDistribution"`
+		Stacking          struct {
 			Group string `json:"group"`
 			Mode  string `json:"mode"`
 		} `json:"stacking"`
-		ThresholdsStyle struct {
-			Mode string `json:"mode"`
-		} `json:"thresholdsStyle"`
+		ThresholdsStyle ThresholdsStyle `json:"thresholdsStyle"`
+	}
+	HideFrom struct {
+		Legend  bool `json:"legend"`
+		Tooltip bool `json:"tooltip"`
+		Viz     bool `json:"viz"`
+	}
+	LineStyle struct {
+		Fill string `json:"fill"`
+	}
+	ScaleDistribution struct {
+		Type string `json:"type"`
+		Log  int    `json:"log,omitempty"`
+	}
+	ThresholdsStyle struct {
+		Mode string `json:"mode"`
 	}
 	Thresholds struct {
 		Mode  string          `json:"mode"`
@@ -436,8 +490,8 @@ type (
 	}
 	CustomPanel          map[string]interface{}
 	FieldOverrideMatcher struct {
-		Id      string `json:"id"`
-		Options string `json:"options"`
+		Id      string      `json:"id"`
+		Options interface{} `json:"options"`
 	}
 	FieldOverrideProperty struct {
 		Id    string `json:"id"`
@@ -558,6 +612,9 @@ type Target struct {
 	Step           int    `json:"step,omitempty"`
 	LegendFormat   string `json:"legendFormat,omitempty"`
 	Instant        bool   `json:"instant,omitempty"`
+	Range          bool   `json:"range,omitempty"`
+	Exemplar       bool   `json:"exemplar,omitempty"`
+	EditorMode     string `json:"editorMode,omitempty"`
 	Format         string `json:"format,omitempty"`
 
 	// For Graphite
@@ -571,6 +628,9 @@ type Target struct {
 	Period     string            `json:"period,omitempty"`
 	Region     string            `json:"region,omitempty"`
 	Label      string            `json:"label,omitempty"`
+
+	// For any other datasource
+	Model map[string]interface{} `json:"-"`
 }
 
 type MapType struct {
@@ -773,6 +833,49 @@ type customPanelOutput struct {
 	CustomPanel
 }
 
+func (t Target) MarshalJSON() ([]byte, error) {
+	type target Target
+	b, err := json.Marshal(target(t))
+	if err != nil || len(t.Model) == 0 {
+		return b, err
+	}
+
+	// Keys defined by the target itself take precedence over the model.
+	var defined map[string]json.RawMessage
+	if err = json.Unmarshal(b, &defined); err != nil {
+		return b, err
+	}
+
+	// Append model keys to marshalled Target.
+	buf := bytes.NewBuffer(b[:len(b)-1])
+
+	// Sort keys to make output idempotent
+	keys := make([]string, 0, len(t.Model))
+	for k := range t.Model {
+		if _, ok := defined[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		key, err := json.Marshal(k)
+		if err != nil {
+			return key, err
+		}
+		buf.WriteString(",")
+		buf.Write(key)
+		buf.WriteString(":")
+		b, err := json.Marshal(t.Model[k])
+		if err != nil {
+			return b, err
+		}
+		buf.Write(b)
+	}
+	buf.WriteString("}")
+	return buf.Bytes(), nil
+}
+
 func (c customPanelOutput) MarshalJSON() ([]byte, error) {
 	b, err := json.Marshal(c.CommonPanel)
 	if err != nil {
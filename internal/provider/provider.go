@@ -41,36 +41,43 @@ type DefaultsModel struct {
 	BarGuage   []BarGaugeDefaultsModel   `tfsdk:"bar_gauge"`
 	Stat       []StatDefaultsModel       `tfsdk:"stat"`
 	Gauge      []GaugeDefaultsModel      `tfsdk:"gauge"`
+	Queries    []QueryDefaultsOptions    `tfsdk:"queries"`
+	Panel      []PanelDefaultsOptions    `tfsdk:"panel"`
 }
 
 type DashboardDefaultsModel struct {
-	Editable     types.Bool   `tfsdk:"editable"`
-	Style        types.String `tfsdk:"style"`
-	GraphTooltip types.String `tfsdk:"graph_tooltip"`
-	Time         []TimeModel  `tfsdk:"time"`
+	Editable     types.Bool    `tfsdk:"editable"`
+	Style        types.String  `tfsdk:"style"`
+	GraphTooltip types.String  `tfsdk:"graph_tooltip"`
+	Time         []TimeModel   `tfsdk:"time"`
+	Annotations  []Annotations `tfsdk:"annotations"`
 }
 
 type TimeseriesDefaultsModel struct {
-	Legend  []TimeseriesLegendOptions  `tfsdk:"legend"`
-	Tooltip []TimeseriesTooltipOptions `tfsdk:"tooltip"`
-	Field   []FieldOptions             `tfsdk:"field"`
-	Axis    []AxisOptions              `tfsdk:"axis"`
-	Graph   []TimeseriesGraphOptions   `tfsdk:"graph"`
+	Legend       []TimeseriesLegendOptions  `tfsdk:"legend"`
+	Tooltip      []TimeseriesTooltipOptions `tfsdk:"tooltip"`
+	Field        []FieldOptions             `tfsdk:"field"`
+	Axis         []AxisOptions              `tfsdk:"axis"`
+	Graph        []TimeseriesGraphOptions   `tfsdk:"graph"`
+	QueryOptions []QueryOptions             `tfsdk:"query_options"`
 }
 
 type BarGaugeDefaultsModel struct {
-	Field []FieldOptions    `tfsdk:"field"`
-	Graph []BarGaugeOptions `tfsdk:"graph"`
+	Field        []FieldOptions    `tfsdk:"field"`
+	Graph        []BarGaugeOptions `tfsdk:"graph"`
+	QueryOptions []QueryOptions    `tfsdk:"query_options"`
 }
 
 type StatDefaultsModel struct {
-	Field []FieldOptions `tfsdk:"field"`
-	Graph []StatOptions  `tfsdk:"graph"`
+	Field        []FieldOptions `tfsdk:"field"`
+	Graph        []StatOptions  `tfsdk:"graph"`
+	QueryOptions []QueryOptions `tfsdk:"query_options"`
 }
 
 type GaugeDefaultsModel struct {
-	Field []FieldOptions `tfsdk:"field"`
-	Graph []GaugeOptions `tfsdk:"graph"`
+	Field        []FieldOptions `tfsdk:"field"`
+	Graph        []GaugeOptions `tfsdk:"graph"`
+	QueryOptions []QueryOptions `tfsdk:"query_options"`
 }
 
 type TimeModel struct {
@@ -92,10 +99,11 @@ func (p *GrafanaDashboardBuilderProvider) Schema(ctx context.Context, req provid
 				NestedObject: schema.NestedBlockObject{
 					Blocks: map[string]schema.Block{
 						"dashboard": schema.ListNestedBlock{
-							Description: "Dashboard defaults.",
+							Description: "Dashboard defaults. The annotations are appended to the annotations of every dashboard.",
 							NestedObject: schema.NestedBlockObject{
 								Blocks: map[string]schema.Block{
-									"time": dashboardTimeBlock(),
+									"time":        dashboardTimeBlock(),
+									"annotations": dashboardAnnotationsBlock(),
 								},
 								Attributes: map[string]schema.Attribute{
 									"editable":      dashboardEditableAttribute(),
@@ -111,11 +119,12 @@ func (p *GrafanaDashboardBuilderProvider) Schema(ctx context.Context, req provid
 							Description: "Timeseries defaults.",
 							NestedObject: schema.NestedBlockObject{
 								Blocks: map[string]schema.Block{
-									"legend":  timeseriesLegendBlock(),
-									"tooltip": timeseriesTooltipBlock(),
-									"field":   fieldBlock(),
-									"axis":    axisBlock(),
-									"graph":   timeseriesGraphBlock(),
+									"legend":        timeseriesLegendBlock(),
+									"tooltip":       timeseriesTooltipBlock(),
+									"field":         fieldBlock(),
+									"axis":          axisBlock(),
+									"graph":         timeseriesGraphBlock(),
+									"query_options": queryOptionsBlock(),
 								},
 							},
 							Validators: []validator.List{
@@ -126,8 +135,9 @@ func (p *GrafanaDashboardBuilderProvider) Schema(ctx context.Context, req provid
 							Description: "Bar gauge defaults.",
 							NestedObject: schema.NestedBlockObject{
 								Blocks: map[string]schema.Block{
-									"field": fieldBlock(),
-									"graph": barGaugeGraphBlock(),
+									"field":         fieldBlock(),
+									"graph":         barGaugeGraphBlock(),
+									"query_options": queryOptionsBlock(),
 								},
 							},
 							Validators: []validator.List{
@@ -138,8 +148,9 @@ func (p *GrafanaDashboardBuilderProvider) Schema(ctx context.Context, req provid
 							Description: "Stat defaults.",
 							NestedObject: schema.NestedBlockObject{
 								Blocks: map[string]schema.Block{
-									"field": fieldBlock(),
-									"graph": statGraphBlock(),
+									"field":         fieldBlock(),
+									"graph":         statGraphBlock(),
+									"query_options": queryOptionsBlock(),
 								},
 							},
 							Validators: []validator.List{
@@ -150,14 +161,17 @@ func (p *GrafanaDashboardBuilderProvider) Schema(ctx context.Context, req provid
 							Description: "Gauge defaults.",
 							NestedObject: schema.NestedBlockObject{
 								Blocks: map[string]schema.Block{
-									"field": fieldBlock(),
-									"graph": gaugeGraphBlock(),
+									"field":         fieldBlock(),
+									"graph":         gaugeGraphBlock(),
+									"query_options": queryOptionsBlock(),
 								},
 							},
 							Validators: []validator.List{
 								listvalidator.SizeAtMost(1),
 							},
 						},
+						"queries": queryDefaultsBlock(),
+						"panel":   panelDefaultsBlock(),
 					},
 				},
 				Validators: []validator.List{
@@ -186,6 +200,7 @@ func (p *GrafanaDashboardBuilderProvider) Configure(ctx context.Context, req pro
 				From: "now-6h",
 				To:   "now",
 			},
+			BuiltInAnnotations: true,
 		},
 		Timeseries: TimeseriesDefaults{
 			Legend: TimeseriesLegendDefault{
@@ -218,6 +233,7 @@ func (p *GrafanaDashboardBuilderProvider) Configure(ctx context.Context, req pro
 				ShowPoints:        "auto",
 				PointSize:         5,
 				StackSeries:       "none",
+				ThresholdsStyle:   "off",
 			},
 		},
 		BarGauge: BarGaugeDefaults{
@@ -225,17 +241,28 @@ func (p *GrafanaDashboardBuilderProvider) Configure(ctx context.Context, req pro
 			Graph: BarGaugeGraphDefault{
 				Orientation:   "auto",
 				DisplayMode:   "gradient",
+				ValueMode:     "color",
+				NamePlacement: "auto",
+				ShowUnfilled:  true,
+				Sizing:        "auto",
+				MinVizWidth:   8,
+				MinVizHeight:  16,
+				MaxVizHeight:  300,
 				ReduceOptions: NewReduceOptionDefaults(),
 			},
 		},
 		Stat: StatDefaults{
 			Field: NewFieldDefaults(),
 			Graph: StatGraphDefaults{
-				Orientation:   "auto",
-				TextMode:      "auto",
-				ColorMode:     "value",
-				GraphMode:     "area",
-				ReduceOptions: NewReduceOptionDefaults(),
+				Orientation:            "auto",
+				TextMode:               "auto",
+				ColorMode:              "value",
+				GraphMode:              "area",
+				TextAlignment:          "auto",
+				ShowPercentChange:      false,
+				PercentChangeColorMode: "standard",
+				WideLayout:             true,
+				ReduceOptions:          NewReduceOptionDefaults(),
 			},
 		},
 		Gauge: GaugeDefaults{
@@ -244,11 +271,46 @@ func (p *GrafanaDashboardBuilderProvider) Configure(ctx context.Context, req pro
 				Orientation:          "auto",
 				ShowThresholdLabels:  false,
 				ShowThresholdMarkers: true,
+				Sizing:               "auto",
+				MinVizWidth:          75,
+				MinVizHeight:         75,
 				ReduceOptions:        NewReduceOptionDefaults(),
 			},
 		},
 	}
 
+	if len(data.Defaults) > 0 && len(data.Defaults[0].Queries) > 0 {
+		opts := data.Defaults[0].Queries[0]
+		queries := QueryDefaults{}
+
+		for _, prometheus := range opts.Prometheus {
+			if !prometheus.Exemplar.IsNull() {
+				queries.Prometheus.Exemplar = prometheus.Exemplar.ValueBool()
+			}
+
+			if !prometheus.EditorMode.IsNull() {
+				queries.Prometheus.EditorMode = prometheus.EditorMode.ValueString()
+			}
+		}
+
+		defaults.Timeseries.Queries = queries
+		defaults.BarGauge.Queries = queries
+		defaults.Stat.Queries = queries
+		defaults.Gauge.Queries = queries
+	}
+
+	if len(data.Defaults) > 0 && len(data.Defaults[0].Panel) > 0 {
+		opts := data.Defaults[0].Panel[0]
+		panel := PanelDefaults{
+			Links: createPanelLinks(PanelDefaults{}, opts.Links),
+		}
+
+		defaults.Timeseries.Panel = panel
+		defaults.BarGauge.Panel = panel
+		defaults.Stat.Panel = panel
+		defaults.Gauge.Panel = panel
+	}
+
 	if len(data.Defaults) > 0 && len(data.Defaults[0].Dashboard) > 0 {
 		opts := data.Defaults[0].Dashboard[0]
 
@@ -268,12 +330,21 @@ func (p *GrafanaDashboardBuilderProvider) Configure(ctx context.Context, req pro
 			defaults.Dashboard.Time.From = time.From.ValueString()
 			defaults.Dashboard.Time.To = time.To.ValueString()
 		}
+
+		for _, annotations := range opts.Annotations {
+			if !annotations.BuiltIn.IsNull() {
+				defaults.Dashboard.BuiltInAnnotations = annotations.BuiltIn.ValueBool()
+			}
+		}
+
+		defaults.Dashboard.Annotations = createAnnotations(opts.Annotations)
 	}
 
 	if len(data.Defaults) > 0 && len(data.Defaults[0].Timeseries) > 0 {
 		opts := data.Defaults[0].Timeseries[0]
 
 		updateFieldDefaults(&defaults.Timeseries.Field, opts.Field)
+		updateQueryOptionsDefaults(&defaults.Timeseries.QueryOptions, opts.QueryOptions)
 
 		for _, graph := range opts.Graph {
 			if !graph.DrawStyle.IsNull() {
@@ -315,6 +386,33 @@ func (p *GrafanaDashboardBuilderProvider) Configure(ctx context.Context, req pro
 			if !graph.StackSeries.IsNull() {
 				defaults.Timeseries.Graph.StackSeries = graph.StackSeries.ValueString()
 			}
+
+			if !graph.Transform.IsNull() {
+				defaults.Timeseries.Graph.Transform = graph.Transform.ValueString()
+			}
+
+			if !graph.ThresholdsStyle.IsNull() {
+				defaults.Timeseries.Graph.ThresholdsStyle = graph.ThresholdsStyle.ValueString()
+			}
+
+			if !graph.BarAlignment.IsNull() {
+				defaults.Timeseries.Graph.BarAlignment = barAlignments[graph.BarAlignment.ValueString()]
+			}
+
+			if !graph.BarWidthFactor.IsNull() {
+				barWidthFactor := graph.BarWidthFactor.ValueFloat64()
+				defaults.Timeseries.Graph.BarWidthFactor = &barWidthFactor
+			}
+
+			if !graph.BarMaxWidth.IsNull() {
+				barMaxWidth := int(graph.BarMaxWidth.ValueInt64())
+				defaults.Timeseries.Graph.BarMaxWidth = &barMaxWidth
+			}
+
+			if !graph.InsertNulls.IsNull() {
+				insertNulls := int(graph.InsertNulls.ValueInt64())
+				defaults.Timeseries.Graph.InsertNulls = &insertNulls
+			}
 		}
 
 		for _, legend := range opts.Legend {
@@ -335,10 +433,48 @@ func (p *GrafanaDashboardBuilderProvider) Configure(ctx context.Context, req pro
 			if !legend.Placement.IsNull() {
 				defaults.Timeseries.Legend.Placement = legend.Placement.ValueString()
 			}
+
+			if !legend.ShowLegend.IsNull() {
+				showLegend := legend.ShowLegend.ValueBool()
+				defaults.Timeseries.Legend.ShowLegend = &showLegend
+			}
+
+			if !legend.Width.IsNull() {
+				width := int(legend.Width.ValueInt64())
+				defaults.Timeseries.Legend.Width = &width
+			}
+
+			if !legend.SortBy.IsNull() {
+				defaults.Timeseries.Legend.SortBy = legend.SortBy.ValueString()
+			}
+
+			if !legend.SortDesc.IsNull() {
+				defaults.Timeseries.Legend.SortDesc = legend.SortDesc.ValueBool()
+			}
 		}
 
 		for _, tooltip := range opts.Tooltip {
-			defaults.Timeseries.Tooltip.Mode = tooltip.Mode.ValueString()
+			if !tooltip.Mode.IsNull() {
+				defaults.Timeseries.Tooltip.Mode = tooltip.Mode.ValueString()
+			}
+
+			if !tooltip.Sort.IsNull() {
+				defaults.Timeseries.Tooltip.Sort = tooltip.Sort.ValueString()
+			}
+
+			if !tooltip.MaxWidth.IsNull() {
+				maxWidth := int(tooltip.MaxWidth.ValueInt64())
+				defaults.Timeseries.Tooltip.MaxWidth = &maxWidth
+			}
+
+			if !tooltip.MaxHeight.IsNull() {
+				maxHeight := int(tooltip.MaxHeight.ValueInt64())
+				defaults.Timeseries.Tooltip.MaxHeight = &maxHeight
+			}
+
+			if !tooltip.HideZeros.IsNull() {
+				defaults.Timeseries.Tooltip.HideZeros = tooltip.HideZeros.ValueBool()
+			}
 		}
 
 		for _, axis := range opts.Axis {
@@ -376,6 +512,7 @@ func (p *GrafanaDashboardBuilderProvider) Configure(ctx context.Context, req pro
 		opts := data.Defaults[0].BarGuage[0]
 
 		updateFieldDefaults(&defaults.BarGauge.Field, opts.Field)
+		updateQueryOptionsDefaults(&defaults.BarGauge.QueryOptions, opts.QueryOptions)
 
 		for _, graph := range opts.Graph {
 			if !graph.Orientation.IsNull() {
@@ -390,6 +527,34 @@ func (p *GrafanaDashboardBuilderProvider) Configure(ctx context.Context, req pro
 				defaults.BarGauge.Graph.TextAlignment = graph.TextAlignment.ValueString()
 			}
 
+			if !graph.ValueMode.IsNull() {
+				defaults.BarGauge.Graph.ValueMode = graph.ValueMode.ValueString()
+			}
+
+			if !graph.NamePlacement.IsNull() {
+				defaults.BarGauge.Graph.NamePlacement = graph.NamePlacement.ValueString()
+			}
+
+			if !graph.ShowUnfilled.IsNull() {
+				defaults.BarGauge.Graph.ShowUnfilled = graph.ShowUnfilled.ValueBool()
+			}
+
+			if !graph.Sizing.IsNull() {
+				defaults.BarGauge.Graph.Sizing = graph.Sizing.ValueString()
+			}
+
+			if !graph.MinVizWidth.IsNull() {
+				defaults.BarGauge.Graph.MinVizWidth = int(graph.MinVizWidth.ValueInt64())
+			}
+
+			if !graph.MinVizHeight.IsNull() {
+				defaults.BarGauge.Graph.MinVizHeight = int(graph.MinVizHeight.ValueInt64())
+			}
+
+			if !graph.MaxVizHeight.IsNull() {
+				defaults.BarGauge.Graph.MaxVizHeight = int(graph.MaxVizHeight.ValueInt64())
+			}
+
 			updateTextSizeDefaults(&defaults.BarGauge.Graph.TextSize, graph.TextSize)
 			updateReduceOptionsDefaults(&defaults.BarGauge.Graph.ReduceOptions, graph.ReduceOptions)
 		}
@@ -399,6 +564,7 @@ func (p *GrafanaDashboardBuilderProvider) Configure(ctx context.Context, req pro
 		opts := data.Defaults[0].Stat[0]
 
 		updateFieldDefaults(&defaults.Stat.Field, opts.Field)
+		updateQueryOptionsDefaults(&defaults.Stat.QueryOptions, opts.QueryOptions)
 
 		for _, graph := range opts.Graph {
 			if !graph.Orientation.IsNull() {
@@ -421,6 +587,18 @@ func (p *GrafanaDashboardBuilderProvider) Configure(ctx context.Context, req pro
 				defaults.Stat.Graph.TextAlignment = graph.TextAlignment.ValueString()
 			}
 
+			if !graph.ShowPercentChange.IsNull() {
+				defaults.Stat.Graph.ShowPercentChange = graph.ShowPercentChange.ValueBool()
+			}
+
+			if !graph.PercentChangeColorMode.IsNull() {
+				defaults.Stat.Graph.PercentChangeColorMode = graph.PercentChangeColorMode.ValueString()
+			}
+
+			if !graph.WideLayout.IsNull() {
+				defaults.Stat.Graph.WideLayout = graph.WideLayout.ValueBool()
+			}
+
 			updateTextSizeDefaults(&defaults.Stat.Graph.TextSize, graph.TextSize)
 			updateReduceOptionsDefaults(&defaults.Stat.Graph.ReduceOptions, graph.ReduceOptions)
 		}
@@ -430,6 +608,7 @@ func (p *GrafanaDashboardBuilderProvider) Configure(ctx context.Context, req pro
 		opts := data.Defaults[0].Gauge[0]
 
 		updateFieldDefaults(&defaults.Gauge.Field, opts.Field)
+		updateQueryOptionsDefaults(&defaults.Gauge.QueryOptions, opts.QueryOptions)
 
 		for _, graph := range opts.Graph {
 			if !graph.Orientation.IsNull() {
@@ -444,6 +623,18 @@ func (p *GrafanaDashboardBuilderProvider) Configure(ctx context.Context, req pro
 				defaults.Gauge.Graph.ShowThresholdMarkers = graph.ShowThresholdMarkers.ValueBool()
 			}
 
+			if !graph.Sizing.IsNull() {
+				defaults.Gauge.Graph.Sizing = graph.Sizing.ValueString()
+			}
+
+			if !graph.MinVizWidth.IsNull() {
+				defaults.Gauge.Graph.MinVizWidth = int(graph.MinVizWidth.ValueInt64())
+			}
+
+			if !graph.MinVizHeight.IsNull() {
+				defaults.Gauge.Graph.MinVizHeight = int(graph.MinVizHeight.ValueInt64())
+			}
+
 			updateTextSizeDefaults(&defaults.Gauge.Graph.TextSize, graph.TextSize)
 			updateReduceOptionsDefaults(&defaults.Gauge.Graph.ReduceOptions, graph.ReduceOptions)
 		}
@@ -456,7 +647,7 @@ func (p *GrafanaDashboardBuilderProvider) Configure(ctx context.Context, req pro
 func updateFieldDefaults(defaults *FieldDefaults, opts []FieldOptions) {
 	for _, field := range opts {
 		if !field.Unit.IsNull() {
-			defaults.Unit = field.Unit.ValueString()
+			defaults.Unit, _ = normalizeUnit(field.Unit.ValueString())
 		}
 
 		if !field.Decimals.IsNull() {
@@ -479,13 +670,31 @@ func updateFieldDefaults(defaults *FieldDefaults, opts []FieldOptions) {
 			defaults.NoValue = &noValue
 		}
 
+		if !field.DisplayName.IsNull() {
+			defaults.DisplayName = field.DisplayName.ValueString()
+		}
+
+		if !field.Description.IsNull() {
+			defaults.Description = field.Description.ValueString()
+		}
+
+		if !field.Filterable.IsNull() {
+			filterable := field.Filterable.ValueBool()
+			defaults.Filterable = &filterable
+		}
+
+		if !field.FieldMinMax.IsNull() {
+			fieldMinMax := field.FieldMinMax.ValueBool()
+			defaults.FieldMinMax = &fieldMinMax
+		}
+
 		for _, color := range field.Color {
 			if !color.Mode.IsNull() {
 				defaults.Color.Mode = color.Mode.ValueString()
 			}
 
 			if !color.FixedColor.IsNull() {
-				defaults.Color.FixedColor = color.FixedColor.ValueString()
+				defaults.Color.FixedColor = normalizeColor(color.FixedColor.ValueString())
 			}
 
 			if !color.SeriesBy.IsNull() {
@@ -493,6 +702,14 @@ func updateFieldDefaults(defaults *FieldDefaults, opts []FieldOptions) {
 			}
 		}
 
+		if mappings := createMappings(field.Mappings); len(mappings) > 0 {
+			defaults.Mappings = mappings
+		}
+
+		if links := createDataLinks(field.Links); len(links) > 0 {
+			defaults.Links = links
+		}
+
 		for _, threshold := range field.Thresholds {
 			steps := make([]ThresholdStepDefaults, len(threshold.Steps))
 
@@ -502,7 +719,7 @@ func updateFieldDefaults(defaults *FieldDefaults, opts []FieldOptions) {
 
 			for i, step := range threshold.Steps {
 				s := ThresholdStepDefaults{
-					Color: step.Color.ValueString(),
+					Color: normalizeColor(step.Color.ValueString()),
 				}
 
 				if !step.Value.IsNull() {
@@ -518,6 +735,35 @@ func updateFieldDefaults(defaults *FieldDefaults, opts []FieldOptions) {
 	}
 }
 
+func updateQueryOptionsDefaults(defaults *QueryOptionsDefaults, opts []QueryOptions) {
+	for _, options := range opts {
+		if !options.MaxDataPoints.IsNull() {
+			maxDataPoints := int(options.MaxDataPoints.ValueInt64())
+			defaults.MaxDataPoints = &maxDataPoints
+		}
+
+		if !options.MinInterval.IsNull() {
+			defaults.MinInterval = options.MinInterval.ValueString()
+		}
+
+		if !options.RelativeTime.IsNull() {
+			defaults.RelativeTime = options.RelativeTime.ValueString()
+		}
+
+		if !options.TimeShift.IsNull() {
+			defaults.TimeShift = options.TimeShift.ValueString()
+		}
+
+		if !options.HideTimeInfo.IsNull() {
+			defaults.HideTimeInfo = options.HideTimeInfo.ValueBool()
+		}
+
+		if !options.CacheTimeout.IsNull() {
+			defaults.CacheTimeout = options.CacheTimeout.ValueString()
+		}
+	}
+}
+
 func updateTextSizeDefaults(defaults *TextSizeDefaults, opts []TextSizeOptions) {
 	for _, textSize := range opts {
 		if !textSize.Title.IsNull() {
@@ -565,6 +811,7 @@ func (p *GrafanaDashboardBuilderProvider) DataSources(ctx context.Context) []fun
 		NewStatDataSource,
 		NewRowDataSource,
 		NewGaugeDataSource,
+		NewVariableDataSource,
 	}
 }
 
@@ -17,6 +17,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ datasource.DataSource = &StatDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &StatDataSource{}
 
 func NewStatDataSource() datasource.DataSource {
 	return &StatDataSource{}
@@ -28,40 +29,52 @@ type StatDataSource struct {
 }
 
 type StatDefaults struct {
-	Field FieldDefaults
-	Graph StatGraphDefaults
+	Field        FieldDefaults
+	Graph        StatGraphDefaults
+	QueryOptions QueryOptionsDefaults
+	Queries      QueryDefaults
+	Panel        PanelDefaults
 }
 
 type StatGraphDefaults struct {
-	Orientation   string
-	TextMode      string
-	ColorMode     string
-	GraphMode     string
-	TextAlignment string
-	ReduceOptions ReduceOptionDefaults
-	TextSize      TextSizeDefaults
+	Orientation            string
+	TextMode               string
+	ColorMode              string
+	GraphMode              string
+	TextAlignment          string
+	ShowPercentChange      bool
+	PercentChangeColorMode string
+	WideLayout             bool
+	ReduceOptions          ReduceOptionDefaults
+	TextSize               TextSizeDefaults
 }
 
 // StatDataSourceModel describes the data source data model.
 type StatDataSourceModel struct {
-	Id          types.String           `tfsdk:"id"`
-	Json        types.String           `tfsdk:"json"`
-	Title       types.String           `tfsdk:"title"`
-	Description types.String           `tfsdk:"description"`
-	Queries     []Query                `tfsdk:"queries"`
-	Field       []FieldOptions         `tfsdk:"field"`
-	Graph       []StatOptions          `tfsdk:"graph"`
-	Overrides   []FieldOverrideOptions `tfsdk:"overrides"`
+	Id              types.String                         `tfsdk:"id"`
+	Json            types.String                         `tfsdk:"json"`
+	Title           types.String                         `tfsdk:"title"`
+	Description     types.String                         `tfsdk:"description"`
+	Queries         []Query                              `tfsdk:"queries"`
+	Field           []FieldOptions                       `tfsdk:"field"`
+	Graph           []StatOptions                        `tfsdk:"graph"`
+	Overrides       []FieldOverrideOptions[FieldOptions] `tfsdk:"overrides"`
+	QueryOptions    []QueryOptions                       `tfsdk:"query_options"`
+	Transformations []Transformations                    `tfsdk:"transformations"`
+	Links           []PanelLinkOptions                   `tfsdk:"links"`
 }
 
 type StatOptions struct {
-	Orientation   types.String      `tfsdk:"orientation"`
-	TextMode      types.String      `tfsdk:"text_mode"`
-	ColorMode     types.String      `tfsdk:"color_mode"`
-	GraphMode     types.String      `tfsdk:"graph_mode"`
-	TextAlignment types.String      `tfsdk:"text_alignment"`
-	TextSize      []TextSizeOptions `tfsdk:"text_size"`
-	ReduceOptions []ReduceOptions   `tfsdk:"options"`
+	Orientation            types.String      `tfsdk:"orientation"`
+	TextMode               types.String      `tfsdk:"text_mode"`
+	ColorMode              types.String      `tfsdk:"color_mode"`
+	GraphMode              types.String      `tfsdk:"graph_mode"`
+	TextAlignment          types.String      `tfsdk:"text_alignment"`
+	ShowPercentChange      types.Bool        `tfsdk:"show_percent_change"`
+	PercentChangeColorMode types.String      `tfsdk:"percent_change_color_mode"`
+	WideLayout             types.Bool        `tfsdk:"wide_layout"`
+	TextSize               []TextSizeOptions `tfsdk:"text_size"`
+	ReduceOptions          []ReduceOptions   `tfsdk:"options"`
 }
 
 func (d *StatDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -117,6 +130,22 @@ func statGraphBlock() schema.Block {
 						stringvalidator.OneOf("auto", "center"),
 					},
 				},
+				"show_percent_change": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the percent change between the first and the last value of the series or not.",
+				},
+				"percent_change_color_mode": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The color of the percent change. The choices are: standard, inverted, same_as_value.",
+					MarkdownDescription: "The color of the percent change. The choices are: `standard` - green for the increase and red for the decrease, `inverted` - red for the increase and green for the decrease, `same_as_value`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("standard", "inverted", "same_as_value"),
+					},
+				},
+				"wide_layout": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to place the name and the value side by side when the panel is wide enough or not.",
+				},
 			},
 		},
 		Validators: []validator.List{
@@ -132,10 +161,13 @@ func (d *StatDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 		MarkdownDescription: "Stat panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/stat/) for more details.",
 
 		Blocks: map[string]schema.Block{
-			"queries":   queryBlock(),
-			"field":     fieldBlock(),
-			"graph":     statGraphBlock(),
-			"overrides": fieldOverrideBlock(),
+			"queries":         queryBlock(),
+			"field":           fieldBlock(),
+			"graph":           statGraphBlock(),
+			"overrides":       fieldOverrideBlock(fieldBlock()),
+			"query_options":   queryOptionsBlock(),
+			"transformations": transformationsBlock(),
+			"links":           panelLinksBlock(),
 		},
 
 		Attributes: map[string]schema.Attribute{
@@ -165,6 +197,16 @@ func (d *StatDataSource) Configure(_ context.Context, req datasource.ConfigureRe
 	d.Defaults = defaults.Stat
 }
 
+func (d *StatDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data StatDataSourceModel
+
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		return
+	}
+
+	validatePanelConfig(data.Queries, data.Overrides, createOverrideProperties, &resp.Diagnostics)
+}
+
 func (d *StatDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data StatDataSourceModel
 
@@ -175,15 +217,25 @@ func (d *StatDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	targets := createTargets(data.Queries)
+	targets := createTargets(d.Defaults.Queries, data.Queries, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	fieldConfig := createFieldConfig(d.Defaults.Field, data.Field)
 
+	showPercentChange := d.Defaults.Graph.ShowPercentChange
+	wideLayout := d.Defaults.Graph.WideLayout
+
 	options := grafana.Options{
 		Orientation: d.Defaults.Graph.Orientation,
 		TextMode:    d.Defaults.Graph.TextMode,
 		ColorMode:   d.Defaults.Graph.ColorMode,
 		GraphMode:   d.Defaults.Graph.GraphMode,
 		JustifyMode: d.Defaults.Graph.TextAlignment,
+		// stat specific
+		ShowPercentChange:      &showPercentChange,
+		PercentChangeColorMode: d.Defaults.Graph.PercentChangeColorMode,
+		WideLayout:             &wideLayout,
 		ReduceOptions: grafana.ReduceOptions{
 			Values: d.Defaults.Graph.ReduceOptions.Values,
 			Fields: d.Defaults.Graph.ReduceOptions.Fields,
@@ -217,6 +269,18 @@ func (d *StatDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 			options.JustifyMode = graph.TextAlignment.ValueString()
 		}
 
+		if !graph.ShowPercentChange.IsNull() {
+			showPercentChange = graph.ShowPercentChange.ValueBool()
+		}
+
+		if !graph.PercentChangeColorMode.IsNull() {
+			options.PercentChangeColorMode = graph.PercentChangeColorMode.ValueString()
+		}
+
+		if !graph.WideLayout.IsNull() {
+			wideLayout = graph.WideLayout.ValueBool()
+		}
+
 		updateTextSize(&options.TextSize, graph.TextSize)
 		updateReduceOptions(&options.ReduceOptions, graph.ReduceOptions)
 	}
@@ -234,7 +298,7 @@ func (d *StatDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 			Options: options,
 			FieldConfig: grafana.FieldConfig{
 				Defaults:  fieldConfig,
-				Overrides: createOverrides(data.Overrides),
+				Overrides: createOverrides(data.Overrides, createOverrideProperties),
 			},
 		},
 	}
@@ -244,6 +308,15 @@ func (d *StatDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 		panel.CommonPanel.Description = &description
 	}
 
+	updateQueryOptions(&panel.CommonPanel, d.Defaults.QueryOptions, data.QueryOptions)
+
+	panel.CommonPanel.Transformations = createTransformations(data.Transformations, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	panel.CommonPanel.Links = createPanelLinks(d.Defaults.Panel, data.Links)
+
 	jsonData, err := json.MarshalIndent(panel, "", "  ")
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
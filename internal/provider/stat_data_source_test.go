@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -33,6 +34,50 @@ func TestAccStatDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.gdashboard_stat.test", "json", testAccStatDataSourceProviderDefaultsConfigExpectedJson),
 				),
 			},
+			{
+				Config: testAccStatDataSourcePercentChangeConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_stat.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_stat.test", "json", testAccStatDataSourcePercentChangeConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccStatDataSourceProviderFieldDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_stat.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_stat.test", "json", testAccStatDataSourceProviderFieldDefaultsConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccStatDataSourceNamedColorCaseConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_stat.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_stat.test", "json", testAccStatDataSourceNamedColorCaseConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccStatDataSourceTransformationsOrderConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_stat.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_stat.test", "json", testAccStatDataSourceTransformationsOrderConfigExpectedJson),
+				),
+			},
+			{
+				Config:      testAccStatDataSourceEmptyTransformationConfig,
+				ExpectError: regexp.MustCompile("exactly one of the blocks must be defined"),
+			},
+			{
+				Config:      testAccStatDataSourceMultipleTransformationsConfig,
+				ExpectError: regexp.MustCompile(`got: 2 \(sort_by, limit\)`),
+			},
+			{
+				Config:      testAccStatDataSourceCalculateFieldModesConfig,
+				ExpectError: regexp.MustCompile(`got: 2 \(reduce_row, binary\)`),
+			},
+			{
+				Config:      testAccStatDataSourceInvalidTransformationCalculationConfig,
+				ExpectError: regexp.MustCompile(`value must be one of:`),
+			},
 		},
 	})
 }
@@ -90,6 +135,165 @@ data "gdashboard_stat" "test" {
       expr    = "up{container_name='container'}"
       instant = true
     }
+
+    raw {
+      datasource_uid  = "loki"
+      datasource_type = "loki"
+      ref_id          = "Logs"
+      hide            = true
+      model           = jsonencode({
+        expr      = "count_over_time({container_name=\"container\"}[5m])"
+        queryType = "range"
+        maxLines  = 1000
+      })
+    }
+  }
+
+  transformations {
+    labels_to_fields {
+      mode        = "rows"
+      value_label = "instance"
+      keep_labels = ["instance"]
+    }
+  }
+
+  transformations {
+    merge {}
+  }
+
+  transformations {
+    organize {
+      exclude = ["Time"]
+      order   = ["instance", "Value"]
+      rename  = {
+        Value = "Status"
+      }
+    }
+  }
+
+  transformations {
+    rename_by_regex {
+      regex          = "(.*)-container"
+      rename_pattern = "$1"
+    }
+  }
+
+  transformations {
+    join_by_field {
+      field = "instance"
+      mode  = "inner"
+    }
+  }
+
+  transformations {
+    reduce {
+      calculations       = ["last", "max"]
+      mode               = "series_to_rows"
+      include_time_field = true
+    }
+  }
+
+  transformations {
+    filter_by_name {
+      include_regex = "^instance|Status$"
+      exclude       = ["Time"]
+    }
+  }
+
+  transformations {
+    filter_by_value {
+      type  = "exclude"
+      match = "all"
+
+      condition {
+        field   = "Status"
+        matcher = "greater_or_equal"
+        value   = "1"
+      }
+
+      condition {
+        field   = "instance"
+        matcher = "regex"
+        value   = "10"
+      }
+
+      condition {
+        field   = "Time"
+        matcher = "is_null"
+      }
+    }
+  }
+
+  transformations {
+    calculate_field {
+      alias          = "Total"
+      replace_fields = false
+
+      reduce_row {
+        calculation = "sum"
+        fields      = ["Status"]
+      }
+    }
+  }
+
+  transformations {
+    calculate_field {
+      binary {
+        left     = "Total"
+        operator = "*"
+        right    = "100"
+      }
+    }
+  }
+
+  transformations {
+    group_by {
+      field {
+        name      = "instance"
+        operation = "group_by"
+      }
+
+      field {
+        name         = "Total"
+        operation    = "aggregate"
+        calculations = ["sum", "mean"]
+      }
+    }
+  }
+
+  transformations {
+    sort_by {
+      field = "Total"
+      desc  = true
+    }
+  }
+
+  transformations {
+    limit {
+      count = 10
+    }
+  }
+
+  transformations {
+    partition_by_values {
+      fields = ["instance"]
+    }
+  }
+
+  transformations {
+    raw {
+      id = "seriesToRows"
+    }
+  }
+
+  transformations {
+    raw {
+      id      = "configFromData"
+      options = jsonencode({
+        configRefId = "Logs"
+        applyTo     = { id = "byType" }
+      })
+    }
   }
 	
 }
@@ -106,6 +310,190 @@ const testAccStatDataSourceConfigExpectedJson = `{
   "description": "Stat description",
   "transparent": false,
   "type": "stat",
+  "transformations": [
+    {
+      "id": "labelsToFields",
+      "options": {
+        "keepLabels": [
+          "instance"
+        ],
+        "mode": "rows",
+        "valueLabel": "instance"
+      }
+    },
+    {
+      "id": "merge",
+      "options": {}
+    },
+    {
+      "id": "organize",
+      "options": {
+        "excludeByName": {
+          "Time": true
+        },
+        "indexByName": {
+          "Value": 1,
+          "instance": 0
+        },
+        "renameByName": {
+          "Value": "Status"
+        }
+      }
+    },
+    {
+      "id": "renameByRegex",
+      "options": {
+        "regex": "(.*)-container",
+        "renamePattern": "$1"
+      }
+    },
+    {
+      "id": "joinByField",
+      "options": {
+        "byField": "instance",
+        "mode": "inner"
+      }
+    },
+    {
+      "id": "reduce",
+      "options": {
+        "includeTimeField": true,
+        "mode": "seriesToRows",
+        "reducers": [
+          "last",
+          "max"
+        ]
+      }
+    },
+    {
+      "id": "filterFieldsByName",
+      "options": {
+        "exclude": {
+          "names": [
+            "Time"
+          ]
+        },
+        "include": {
+          "pattern": "^instance|Status$"
+        }
+      }
+    },
+    {
+      "id": "filterByValue",
+      "options": {
+        "filters": [
+          {
+            "config": {
+              "id": "greaterOrEqual",
+              "options": {
+                "value": 1
+              }
+            },
+            "fieldName": "Status"
+          },
+          {
+            "config": {
+              "id": "regex",
+              "options": {
+                "value": "10"
+              }
+            },
+            "fieldName": "instance"
+          },
+          {
+            "config": {
+              "id": "isNull",
+              "options": {}
+            },
+            "fieldName": "Time"
+          }
+        ],
+        "match": "all",
+        "type": "exclude"
+      }
+    },
+    {
+      "id": "calculateField",
+      "options": {
+        "alias": "Total",
+        "mode": "reduceRow",
+        "reduce": {
+          "include": [
+            "Status"
+          ],
+          "reducer": "sum"
+        },
+        "replaceFields": false
+      }
+    },
+    {
+      "id": "calculateField",
+      "options": {
+        "binary": {
+          "left": "Total",
+          "operator": "*",
+          "right": "100"
+        },
+        "mode": "binary"
+      }
+    },
+    {
+      "id": "groupBy",
+      "options": {
+        "fields": {
+          "Total": {
+            "aggregations": [
+              "sum",
+              "mean"
+            ],
+            "operation": "aggregate"
+          },
+          "instance": {
+            "aggregations": [],
+            "operation": "groupby"
+          }
+        }
+      }
+    },
+    {
+      "id": "sortBy",
+      "options": {
+        "sort": [
+          {
+            "desc": true,
+            "field": "Total"
+          }
+        ]
+      }
+    },
+    {
+      "id": "limit",
+      "options": {
+        "limitField": 10
+      }
+    },
+    {
+      "id": "partitionByValues",
+      "options": {
+        "fields": [
+          "instance"
+        ]
+      }
+    },
+    {
+      "id": "seriesToRows",
+      "options": {}
+    },
+    {
+      "id": "configFromData",
+      "options": {
+        "applyTo": {
+          "id": "byType"
+        },
+        "configRefId": "Logs"
+      }
+    }
+  ],
   "colors": null,
   "colorValue": false,
   "colorBackground": false,
@@ -122,7 +510,7 @@ const testAccStatDataSourceConfigExpectedJson = `{
   "sparkline": {},
   "targets": [
     {
-      "refId": "",
+      "refId": "A",
       "datasource": {
         "id": 0,
         "orgId": 0,
@@ -138,6 +526,26 @@ const testAccStatDataSourceConfigExpectedJson = `{
       },
       "expr": "up{container_name='container'}",
       "instant": true
+    },
+    {
+      "refId": "Logs",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "loki",
+        "name": "",
+        "type": "loki",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "hide": true,
+      "expr": "count_over_time({container_name=\"container\"}[5m])",
+      "maxLines": 1000,
+      "queryType": "range"
     }
   ],
   "thresholds": "",
@@ -149,10 +557,13 @@ const testAccStatDataSourceConfigExpectedJson = `{
     "textMode": "value",
     "colorMode": "background",
     "graphMode": "none",
-    "justifyMode": "",
+    "justifyMode": "auto",
     "displayMode": "",
     "content": "",
     "mode": "",
+    "showPercentChange": false,
+    "percentChangeColorMode": "standard",
+    "wideLayout": true,
     "text": {
       "titleSize": 10,
       "valueSize": 15
@@ -307,10 +718,13 @@ const testAccStatDataSourceProviderCustomDefaultsConfigExpectedJson = `{
     "textMode": "value",
     "colorMode": "background",
     "graphMode": "none",
-    "justifyMode": "",
+    "justifyMode": "auto",
     "displayMode": "",
     "content": "",
     "mode": "",
+    "showPercentChange": false,
+    "percentChangeColorMode": "standard",
+    "wideLayout": true,
     "text": {
       "titleSize": 10,
       "valueSize": 15
@@ -413,10 +827,13 @@ const testAccStatDataSourceProviderDefaultsConfigExpectedJson = `{
     "textMode": "auto",
     "colorMode": "value",
     "graphMode": "area",
-    "justifyMode": "",
+    "justifyMode": "auto",
     "displayMode": "",
     "content": "",
     "mode": "",
+    "showPercentChange": false,
+    "percentChangeColorMode": "standard",
+    "wideLayout": true,
     "text": {},
     "reduceOptions": {
       "values": false,
@@ -476,3 +893,606 @@ const testAccStatDataSourceProviderDefaultsConfigExpectedJson = `{
     }
   }
 }`
+
+const testAccStatDataSourcePercentChangeConfig = `
+provider "gdashboard" {
+  defaults {
+    stat {
+      graph {
+        text_mode           = "name"
+        show_percent_change = true
+      }
+    }
+  }
+}
+
+data "gdashboard_stat" "test" {
+  title = "Test"
+
+  graph {
+    text_mode                 = "value_and_name"
+    text_alignment            = "center"
+    percent_change_color_mode = "inverted"
+    wide_layout               = false
+  }
+}
+`
+
+const testAccStatDataSourcePercentChangeConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "stat",
+  "colors": null,
+  "colorValue": false,
+  "colorBackground": false,
+  "decimals": 0,
+  "format": "",
+  "gauge": {
+    "maxValue": 0,
+    "minValue": 0,
+    "show": false,
+    "thresholdLabels": false,
+    "thresholdMarkers": false
+  },
+  "nullPointMode": "",
+  "sparkline": {},
+  "thresholds": "",
+  "valueFontSize": "",
+  "valueMaps": null,
+  "valueName": "",
+  "options": {
+    "orientation": "auto",
+    "textMode": "value_and_name",
+    "colorMode": "value",
+    "graphMode": "area",
+    "justifyMode": "center",
+    "displayMode": "",
+    "content": "",
+    "mode": "",
+    "showPercentChange": true,
+    "percentChangeColorMode": "inverted",
+    "wideLayout": false,
+    "text": {},
+    "reduceOptions": {
+      "values": false,
+      "fields": "",
+      "calcs": [
+        "lastNotNull"
+      ]
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccStatDataSourceProviderFieldDefaultsConfig = `
+provider "gdashboard" {
+  defaults {
+    stat {
+      field {
+        mappings {
+          value {
+            value        = "1"
+            display_text = "UP"
+            color        = "green"
+          }
+        }
+
+        link {
+          title = "Details"
+          url   = "/d/details?var-value=$${__value.raw}"
+        }
+      }
+    }
+  }
+}
+
+data "gdashboard_stat" "test" {
+  title = "Test"
+}
+`
+
+const testAccStatDataSourceProviderFieldDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "stat",
+  "colors": null,
+  "colorValue": false,
+  "colorBackground": false,
+  "decimals": 0,
+  "format": "",
+  "gauge": {
+    "maxValue": 0,
+    "minValue": 0,
+    "show": false,
+    "thresholdLabels": false,
+    "thresholdMarkers": false
+  },
+  "nullPointMode": "",
+  "sparkline": {},
+  "thresholds": "",
+  "valueFontSize": "",
+  "valueMaps": null,
+  "valueName": "",
+  "options": {
+    "orientation": "auto",
+    "textMode": "auto",
+    "colorMode": "value",
+    "graphMode": "area",
+    "justifyMode": "auto",
+    "displayMode": "",
+    "content": "",
+    "mode": "",
+    "showPercentChange": false,
+    "percentChangeColorMode": "standard",
+    "wideLayout": true,
+    "text": {},
+    "reduceOptions": {
+      "values": false,
+      "fields": "",
+      "calcs": [
+        "lastNotNull"
+      ]
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      },
+      "mappings": [
+        {
+          "type": "value",
+          "options": {
+            "1": {
+              "color": "green",
+              "text": "UP",
+              "index": 0
+            }
+          }
+        }
+      ],
+      "links": [
+        {
+          "title": "Details",
+          "url": "/d/details?var-value=${__value.raw}"
+        }
+      ]
+    }
+  }
+}`
+
+const testAccStatDataSourceNamedColorCaseConfig = `
+data "gdashboard_stat" "test" {
+  title = "Test"
+
+  field {
+    color {
+      mode        = "fixed"
+      fixed_color = "Semi-Dark-Red"
+    }
+  }
+}
+`
+
+const testAccStatDataSourceNamedColorCaseConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "stat",
+  "colors": null,
+  "colorValue": false,
+  "colorBackground": false,
+  "decimals": 0,
+  "format": "",
+  "gauge": {
+    "maxValue": 0,
+    "minValue": 0,
+    "show": false,
+    "thresholdLabels": false,
+    "thresholdMarkers": false
+  },
+  "nullPointMode": "",
+  "sparkline": {},
+  "thresholds": "",
+  "valueFontSize": "",
+  "valueMaps": null,
+  "valueName": "",
+  "options": {
+    "orientation": "auto",
+    "textMode": "auto",
+    "colorMode": "value",
+    "graphMode": "area",
+    "justifyMode": "auto",
+    "displayMode": "",
+    "content": "",
+    "mode": "",
+    "showPercentChange": false,
+    "percentChangeColorMode": "standard",
+    "wideLayout": true,
+    "text": {},
+    "reduceOptions": {
+      "values": false,
+      "fields": "",
+      "calcs": [
+        "lastNotNull"
+      ]
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "fixed",
+        "fixedColor": "semi-dark-red",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccStatDataSourceTransformationsOrderConfig = `
+data "gdashboard_stat" "test" {
+  title = "Test"
+
+  transformations {
+    limit {
+      count = 5
+    }
+  }
+
+  transformations {
+    calculate_field {
+      alias = "Total"
+
+      binary {
+        left     = "A"
+        operator = "+"
+        right    = "B"
+      }
+    }
+  }
+
+  transformations {
+    sort_by {
+      field = "Total"
+    }
+  }
+}
+`
+
+const testAccStatDataSourceTransformationsOrderConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "stat",
+  "transformations": [
+    {
+      "id": "limit",
+      "options": {
+        "limitField": 5
+      }
+    },
+    {
+      "id": "calculateField",
+      "options": {
+        "alias": "Total",
+        "binary": {
+          "left": "A",
+          "operator": "+",
+          "right": "B"
+        },
+        "mode": "binary"
+      }
+    },
+    {
+      "id": "sortBy",
+      "options": {
+        "sort": [
+          {
+            "desc": false,
+            "field": "Total"
+          }
+        ]
+      }
+    }
+  ],
+  "colors": null,
+  "colorValue": false,
+  "colorBackground": false,
+  "decimals": 0,
+  "format": "",
+  "gauge": {
+    "maxValue": 0,
+    "minValue": 0,
+    "show": false,
+    "thresholdLabels": false,
+    "thresholdMarkers": false
+  },
+  "nullPointMode": "",
+  "sparkline": {},
+  "thresholds": "",
+  "valueFontSize": "",
+  "valueMaps": null,
+  "valueName": "",
+  "options": {
+    "orientation": "auto",
+    "textMode": "auto",
+    "colorMode": "value",
+    "graphMode": "area",
+    "justifyMode": "auto",
+    "displayMode": "",
+    "content": "",
+    "mode": "",
+    "showPercentChange": false,
+    "percentChangeColorMode": "standard",
+    "wideLayout": true,
+    "text": {},
+    "reduceOptions": {
+      "values": false,
+      "fields": "",
+      "calcs": [
+        "lastNotNull"
+      ]
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "",
+        "barAlignment": 0,
+        "drawStyle": "",
+        "fillOpacity": 0,
+        "gradientMode": "",
+        "lineInterpolation": "",
+        "lineWidth": 0,
+        "pointSize": 0,
+        "showPoints": "",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": ""
+        },
+        "scaleDistribution": {
+          "type": ""
+        },
+        "stacking": {
+          "group": "",
+          "mode": ""
+        },
+        "thresholdsStyle": {
+          "mode": ""
+        }
+      }
+    }
+  }
+}`
+
+const testAccStatDataSourceEmptyTransformationConfig = `
+data "gdashboard_stat" "test" {
+  title = "Test"
+
+  transformations {}
+}
+`
+
+const testAccStatDataSourceMultipleTransformationsConfig = `
+data "gdashboard_stat" "test" {
+  title = "Test"
+
+  transformations {
+    sort_by {
+      field = "Total"
+    }
+
+    limit {
+      count = 10
+    }
+  }
+}
+`
+
+const testAccStatDataSourceCalculateFieldModesConfig = `
+data "gdashboard_stat" "test" {
+  title = "Test"
+
+  transformations {
+    calculate_field {
+      reduce_row {
+        calculation = "sum"
+      }
+
+      binary {
+        left     = "A"
+        operator = "+"
+        right    = "B"
+      }
+    }
+  }
+}
+`
+
+const testAccStatDataSourceInvalidTransformationCalculationConfig = `
+data "gdashboard_stat" "test" {
+  title = "Test"
+
+  transformations {
+    group_by {
+      field {
+        name         = "instance"
+        operation    = "aggregate"
+        calculations = ["avg"]
+      }
+    }
+  }
+}
+`
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -18,6 +19,7 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces
 var _ datasource.DataSource = &TimeseriesDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &TimeseriesDataSource{}
 
 func NewTimeseriesDataSource() datasource.DataSource {
 	return &TimeseriesDataSource{}
@@ -29,11 +31,14 @@ type TimeseriesDataSource struct {
 }
 
 type TimeseriesDefaults struct {
-	Legend  TimeseriesLegendDefault
-	Tooltip TimeseriesTooltipDefaults
-	Field   FieldDefaults
-	Axis    AxisDefaults
-	Graph   TimeseriesGraphDefault
+	Legend       TimeseriesLegendDefault
+	Tooltip      TimeseriesTooltipDefaults
+	Field        FieldDefaults
+	Axis         AxisDefaults
+	Graph        TimeseriesGraphDefault
+	QueryOptions QueryOptionsDefaults
+	Queries      QueryDefaults
+	Panel        PanelDefaults
 }
 
 type TimeseriesGraphDefault struct {
@@ -47,54 +52,139 @@ type TimeseriesGraphDefault struct {
 	ShowPoints        string
 	PointSize         int
 	StackSeries       string
+	Transform         string
+	ThresholdsStyle   string
+	BarAlignment      int
+	BarWidthFactor    *float64
+	BarMaxWidth       *int
+	InsertNulls       *int
 }
 
 type TimeseriesTooltipDefaults struct {
-	Mode string
+	Mode      string
+	Sort      string
+	MaxWidth  *int
+	MaxHeight *int
+	HideZeros bool
 }
 
 type TimeseriesLegendDefault struct {
 	Calculations []string
 	DisplayMode  string
 	Placement    string
+	ShowLegend   *bool
+	Width        *int
+	SortBy       string
+	SortDesc     bool
 }
 
 // TimeseriesDataSourceModel describes the data source data model.
 type TimeseriesDataSourceModel struct {
-	Id          types.String               `tfsdk:"id"`
-	Json        types.String               `tfsdk:"json"`
-	Title       types.String               `tfsdk:"title"`
-	Description types.String               `tfsdk:"description"`
-	Queries     []Query                    `tfsdk:"queries"`
-	Legend      []TimeseriesLegendOptions  `tfsdk:"legend"`
-	Tooltip     []TimeseriesTooltipOptions `tfsdk:"tooltip"`
-	Field       []FieldOptions             `tfsdk:"field"`
-	Axis        []AxisOptions              `tfsdk:"axis"`
-	Graph       []TimeseriesGraphOptions   `tfsdk:"graph"`
-	Overrides   []FieldOverrideOptions     `tfsdk:"overrides"`
+	Id              types.String                                   `tfsdk:"id"`
+	Json            types.String                                   `tfsdk:"json"`
+	Title           types.String                                   `tfsdk:"title"`
+	Description     types.String                                   `tfsdk:"description"`
+	Queries         []Query                                        `tfsdk:"queries"`
+	Legend          []TimeseriesLegendOptions                      `tfsdk:"legend"`
+	Tooltip         []TimeseriesTooltipOptions                     `tfsdk:"tooltip"`
+	Field           []FieldOptions                                 `tfsdk:"field"`
+	Axis            []AxisOptions                                  `tfsdk:"axis"`
+	Graph           []TimeseriesGraphOptions                       `tfsdk:"graph"`
+	Overrides       []FieldOverrideOptions[TimeseriesFieldOptions] `tfsdk:"overrides"`
+	QueryOptions    []QueryOptions                                 `tfsdk:"query_options"`
+	Transformations []Transformations                              `tfsdk:"transformations"`
+	Links           []PanelLinkOptions                             `tfsdk:"links"`
 }
 
 type TimeseriesLegendOptions struct {
 	Calculations []types.String `tfsdk:"calculations"`
 	DisplayMode  types.String   `tfsdk:"display_mode"`
 	Placement    types.String   `tfsdk:"placement"`
+	ShowLegend   types.Bool     `tfsdk:"show_legend"`
+	Width        types.Int64    `tfsdk:"width"`
+	SortBy       types.String   `tfsdk:"sort_by"`
+	SortDesc     types.Bool     `tfsdk:"sort_desc"`
 }
 
 type TimeseriesTooltipOptions struct {
-	Mode types.String `tfsdk:"mode"`
+	Mode      types.String `tfsdk:"mode"`
+	Sort      types.String `tfsdk:"sort"`
+	MaxWidth  types.Int64  `tfsdk:"max_width"`
+	MaxHeight types.Int64  `tfsdk:"max_height"`
+	HideZeros types.Bool   `tfsdk:"hide_zeros"`
 }
 
 type TimeseriesGraphOptions struct {
-	DrawStyle         types.String `tfsdk:"draw_style"`
-	LineInterpolation types.String `tfsdk:"line_interpolation"`
-	LineWidth         types.Int64  `tfsdk:"line_width"`
-	FillOpacity       types.Int64  `tfsdk:"fill_opacity"`
-	GradientMode      types.String `tfsdk:"gradient_mode"`
-	LineStyle         types.String `tfsdk:"line_style"`
-	SpanNulls         types.Bool   `tfsdk:"span_nulls"`
-	ShowPoints        types.String `tfsdk:"show_points"`
-	PointSize         types.Int64  `tfsdk:"point_size"`
-	StackSeries       types.String `tfsdk:"stack_series"`
+	DrawStyle         types.String  `tfsdk:"draw_style"`
+	LineInterpolation types.String  `tfsdk:"line_interpolation"`
+	LineWidth         types.Int64   `tfsdk:"line_width"`
+	FillOpacity       types.Int64   `tfsdk:"fill_opacity"`
+	GradientMode      types.String  `tfsdk:"gradient_mode"`
+	LineStyle         types.String  `tfsdk:"line_style"`
+	SpanNulls         types.Bool    `tfsdk:"span_nulls"`
+	ShowPoints        types.String  `tfsdk:"show_points"`
+	PointSize         types.Int64   `tfsdk:"point_size"`
+	StackSeries       types.String  `tfsdk:"stack_series"`
+	Transform         types.String  `tfsdk:"transform"`
+	ThresholdsStyle   types.String  `tfsdk:"thresholds_style"`
+	BarAlignment      types.String  `tfsdk:"bar_alignment"`
+	BarWidthFactor    types.Float64 `tfsdk:"bar_width_factor"`
+	BarMaxWidth       types.Int64   `tfsdk:"bar_max_width"`
+	InsertNulls       types.Int64   `tfsdk:"insert_nulls"`
+}
+
+// barAlignments maps the bar alignments to the values expected by Grafana
+var barAlignments = map[string]int{
+	"before": -1,
+	"center": 0,
+	"after":  1,
+}
+
+// TimeseriesFieldOptions extends the standard field options with the timeseries specific properties of an override.
+// The framework does not support embedded structs, the standard options are converted by fieldOptions instead.
+type TimeseriesFieldOptions struct {
+	Unit        types.String             `tfsdk:"unit"`
+	Decimals    types.Int64              `tfsdk:"decimals"`
+	Min         types.Float64            `tfsdk:"min"`
+	Max         types.Float64            `tfsdk:"max"`
+	NoValue     types.Float64            `tfsdk:"no_value"`
+	DisplayName types.String             `tfsdk:"display_name"`
+	Description types.String             `tfsdk:"description"`
+	Filterable  types.Bool               `tfsdk:"filterable"`
+	FieldMinMax types.Bool               `tfsdk:"field_min_max"`
+	Color       []ColorOptions           `tfsdk:"color"`
+	Mappings    []MappingOptions         `tfsdk:"mappings"`
+	Thresholds  []ThresholdOptions       `tfsdk:"thresholds"`
+	Links       []DataLinkOptions        `tfsdk:"link"`
+	Axis        []AxisOptions            `tfsdk:"axis"`
+	Graph       []TimeseriesGraphOptions `tfsdk:"graph"`
+	HideFrom    []HideFromOptions        `tfsdk:"hide_from"`
+}
+
+// fieldOptions returns the standard field options. The schema of the block is derived from fieldBlock,
+// hence a field missing here fails the conversion of the config instead of being silently dropped.
+func (o TimeseriesFieldOptions) fieldOptions() FieldOptions {
+	return FieldOptions{
+		Unit:        o.Unit,
+		Decimals:    o.Decimals,
+		Min:         o.Min,
+		Max:         o.Max,
+		NoValue:     o.NoValue,
+		DisplayName: o.DisplayName,
+		Description: o.Description,
+		Filterable:  o.Filterable,
+		FieldMinMax: o.FieldMinMax,
+		Color:       o.Color,
+		Mappings:    o.Mappings,
+		Thresholds:  o.Thresholds,
+		Links:       o.Links,
+	}
+}
+
+type HideFromOptions struct {
+	Legend  types.Bool `tfsdk:"legend"`
+	Tooltip types.Bool `tfsdk:"tooltip"`
+	Viz     types.Bool `tfsdk:"viz"`
 }
 
 func (d *TimeseriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -114,6 +204,34 @@ func timeseriesTooltipBlock() schema.Block {
 						stringvalidator.OneOf("multi", "single", "hidden"),
 					},
 				},
+				"sort": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The order of the series in the multi tooltip. The choices are: none, asc, desc.",
+					MarkdownDescription: "The order of the series in the `multi` tooltip. The choices are: `none`, `asc`, `desc`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("none", "asc", "desc"),
+					},
+				},
+				"max_width": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The maximum width of the tooltip in pixels. Must be at least 1.",
+					MarkdownDescription: "The maximum width of the tooltip in pixels. Must be at least `1`.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				"max_height": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The maximum height of the tooltip in pixels. Must be at least 1.",
+					MarkdownDescription: "The maximum height of the tooltip in pixels. Must be at least `1`.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				"hide_zeros": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to hide the series with zero values from the tooltip or not.",
+				},
 			},
 		},
 		Validators: []validator.List{
@@ -203,6 +321,54 @@ func timeseriesGraphBlock() schema.Block {
 						stringvalidator.OneOf("none", "normal", "percent"),
 					},
 				},
+				"transform": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The transformation of the series values. The choices are: constant, negative-Y.",
+					MarkdownDescription: "The transformation of the series values. The choices are: `constant`, `negative-Y`. The `negative-Y` flips the series below the x-axis, e.g. to display the inbound and outbound traffic on the same graph.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("constant", "negative-Y"),
+					},
+				},
+				"thresholds_style": schema.StringAttribute{
+					Optional:            true,
+					Description:         "Choose how to display the thresholds on the graph. The choices are: off, line, dashed, area, line+area, dashed+area.",
+					MarkdownDescription: "Choose how to display the thresholds on the graph. The choices are: `off`, `line`, `dashed`, `area`, `line+area`, `dashed+area`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("off", "line", "dashed", "area", "line+area", "dashed+area"),
+					},
+				},
+				"bar_alignment": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The position of the bar relative to the data point. Applies to the bars draw style. The choices are: before, center, after.",
+					MarkdownDescription: "The position of the bar relative to the data point. Applies to the `bars` draw style. The choices are: `before`, `center`, `after`.",
+					Validators: []validator.String{
+						stringvalidator.OneOf("before", "center", "after"),
+					},
+				},
+				"bar_width_factor": schema.Float64Attribute{
+					Optional:            true,
+					Description:         "The width of the bar relative to the space between the data points. Applies to the bars draw style. Must be between 0 and 1 (inclusive).",
+					MarkdownDescription: "The width of the bar relative to the space between the data points. Applies to the `bars` draw style. Must be between `0` and `1` (inclusive).",
+					Validators: []validator.Float64{
+						float64validator.Between(0, 1),
+					},
+				},
+				"bar_max_width": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The maximum width of the bar in pixels. Applies to the bars draw style. Must be at least 1.",
+					MarkdownDescription: "The maximum width of the bar in pixels. Applies to the `bars` draw style. Must be at least `1`.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				"insert_nulls": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The threshold in milliseconds. The line is disconnected when the gap between the data points exceeds the threshold.",
+					MarkdownDescription: "The threshold in milliseconds. The line is disconnected when the gap between the data points exceeds the threshold, e.g. `3600000` for `1h`.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
 			},
 		},
 		Validators: []validator.List{
@@ -211,6 +377,42 @@ func timeseriesGraphBlock() schema.Block {
 	}
 }
 
+func timeseriesHideFromBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "Hides the series from the legend, the tooltip or the graph.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"legend": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to hide the series from the legend or not.",
+				},
+				"tooltip": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to hide the series from the tooltip or not.",
+				},
+				"viz": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to hide the series from the graph or not.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+// timeseriesFieldBlock extends the field block with the timeseries options that can be overridden per series.
+func timeseriesFieldBlock() schema.Block {
+	block := fieldBlock().(schema.ListNestedBlock)
+
+	block.NestedObject.Blocks["axis"] = axisBlock()
+	block.NestedObject.Blocks["graph"] = timeseriesGraphBlock()
+	block.NestedObject.Blocks["hide_from"] = timeseriesHideFromBlock()
+
+	return block
+}
+
 func timeseriesLegendBlock() schema.Block {
 	return schema.ListNestedBlock{
 		Description: "Legend options.",
@@ -237,6 +439,27 @@ func timeseriesLegendBlock() schema.Block {
 						stringvalidator.OneOf("bottom", "right"),
 					},
 				},
+				"show_legend": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to show the legend or not.",
+				},
+				"width": schema.Int64Attribute{
+					Optional:            true,
+					Description:         "The width of the legend in pixels, when it is placed on the right. Must be at least 1.",
+					MarkdownDescription: "The width of the legend in pixels, when it is placed on the `right`. Must be at least `1`.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				"sort_by": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The name of the table column to sort the legend by, e.g. Name, Max, Mean. Applies to the table display mode.",
+					MarkdownDescription: "The name of the table column to sort the legend by, e.g. `Name`, `Max`, `Mean`. Applies to the `table` display mode.",
+				},
+				"sort_desc": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to sort the legend in the descending order or not.",
+				},
 			},
 		},
 		Validators: []validator.List{
@@ -252,13 +475,16 @@ func (d *TimeseriesDataSource) Schema(ctx context.Context, req datasource.Schema
 		MarkdownDescription: "Time series panel data source. See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/visualizations/time-series/).",
 
 		Blocks: map[string]schema.Block{
-			"queries":   queryBlock(),
-			"legend":    timeseriesLegendBlock(),
-			"tooltip":   timeseriesTooltipBlock(),
-			"field":     fieldBlock(),
-			"axis":      axisBlock(),
-			"graph":     timeseriesGraphBlock(),
-			"overrides": fieldOverrideBlock(),
+			"queries":         queryBlock(),
+			"legend":          timeseriesLegendBlock(),
+			"tooltip":         timeseriesTooltipBlock(),
+			"field":           fieldBlock(),
+			"axis":            axisBlock(),
+			"graph":           timeseriesGraphBlock(),
+			"overrides":       fieldOverrideBlock(timeseriesFieldBlock()),
+			"query_options":   queryOptionsBlock(),
+			"transformations": transformationsBlock(),
+			"links":           panelLinksBlock(),
 		},
 
 		Attributes: map[string]schema.Attribute{
@@ -288,6 +514,16 @@ func (d *TimeseriesDataSource) Configure(_ context.Context, req datasource.Confi
 	d.Defaults = defaults.Timeseries
 }
 
+func (d *TimeseriesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data TimeseriesDataSourceModel
+
+	if diags := req.Config.Get(ctx, &data); diags.HasError() {
+		return
+	}
+
+	validatePanelConfig(data.Queries, data.Overrides, createTimeseriesOverrideProperties, &resp.Diagnostics)
+}
+
 func (d *TimeseriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var data TimeseriesDataSourceModel
 
@@ -298,16 +534,27 @@ func (d *TimeseriesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	targets := createTargets(data.Queries)
+	targets := createTargets(d.Defaults.Queries, data.Queries, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	legendOptions := grafana.TimeseriesLegendOptions{
 		Calcs:       d.Defaults.Legend.Calculations,
 		DisplayMode: d.Defaults.Legend.DisplayMode,
 		Placement:   d.Defaults.Legend.Placement,
+		ShowLegend:  d.Defaults.Legend.ShowLegend,
+		Width:       d.Defaults.Legend.Width,
+		SortBy:      d.Defaults.Legend.SortBy,
+		SortDesc:    d.Defaults.Legend.SortDesc,
 	}
 
 	tooltipOptions := grafana.TimeseriesTooltipOptions{
-		Mode: d.Defaults.Tooltip.Mode,
+		Mode:      d.Defaults.Tooltip.Mode,
+		Sort:      d.Defaults.Tooltip.Sort,
+		MaxWidth:  d.Defaults.Tooltip.MaxWidth,
+		MaxHeight: d.Defaults.Tooltip.MaxHeight,
+		HideZeros: d.Defaults.Tooltip.HideZeros,
 	}
 
 	for _, legend := range data.Legend {
@@ -327,10 +574,48 @@ func (d *TimeseriesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		if !legend.Placement.IsNull() {
 			legendOptions.Placement = legend.Placement.ValueString()
 		}
+
+		if !legend.ShowLegend.IsNull() {
+			showLegend := legend.ShowLegend.ValueBool()
+			legendOptions.ShowLegend = &showLegend
+		}
+
+		if !legend.Width.IsNull() {
+			width := int(legend.Width.ValueInt64())
+			legendOptions.Width = &width
+		}
+
+		if !legend.SortBy.IsNull() {
+			legendOptions.SortBy = legend.SortBy.ValueString()
+		}
+
+		if !legend.SortDesc.IsNull() {
+			legendOptions.SortDesc = legend.SortDesc.ValueBool()
+		}
 	}
 
 	for _, tooltip := range data.Tooltip {
-		tooltipOptions.Mode = tooltip.Mode.ValueString()
+		if !tooltip.Mode.IsNull() {
+			tooltipOptions.Mode = tooltip.Mode.ValueString()
+		}
+
+		if !tooltip.Sort.IsNull() {
+			tooltipOptions.Sort = tooltip.Sort.ValueString()
+		}
+
+		if !tooltip.MaxWidth.IsNull() {
+			maxWidth := int(tooltip.MaxWidth.ValueInt64())
+			tooltipOptions.MaxWidth = &maxWidth
+		}
+
+		if !tooltip.MaxHeight.IsNull() {
+			maxHeight := int(tooltip.MaxHeight.ValueInt64())
+			tooltipOptions.MaxHeight = &maxHeight
+		}
+
+		if !tooltip.HideZeros.IsNull() {
+			tooltipOptions.HideZeros = tooltip.HideZeros.ValueBool()
+		}
 	}
 
 	fieldConfig := createFieldConfig(d.Defaults.Field, data.Field)
@@ -345,6 +630,11 @@ func (d *TimeseriesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		SpanNulls:         d.Defaults.Graph.SpanNulls,
 		ShowPoints:        d.Defaults.Graph.ShowPoints,
 		PointSize:         d.Defaults.Graph.PointSize,
+		Transform:         d.Defaults.Graph.Transform,
+		BarAlignment:      d.Defaults.Graph.BarAlignment,
+		BarWidthFactor:    d.Defaults.Graph.BarWidthFactor,
+		BarMaxWidth:       d.Defaults.Graph.BarMaxWidth,
+		InsertNulls:       d.Defaults.Graph.InsertNulls,
 		// axis
 		AxisLabel:     d.Defaults.Axis.Label,
 		AxisPlacement: d.Defaults.Axis.Placement,
@@ -354,6 +644,7 @@ func (d *TimeseriesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 
 	fieldConfig.Custom.LineStyle.Fill = d.Defaults.Graph.LineStyle
 	fieldConfig.Custom.Stacking.Mode = d.Defaults.Graph.StackSeries
+	fieldConfig.Custom.ThresholdsStyle.Mode = d.Defaults.Graph.ThresholdsStyle
 	fieldConfig.Custom.ScaleDistribution.Type = d.Defaults.Axis.Scale.Type
 	fieldConfig.Custom.ScaleDistribution.Log = d.Defaults.Axis.Scale.Log
 
@@ -427,6 +718,33 @@ func (d *TimeseriesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		if !graph.StackSeries.IsNull() {
 			fieldConfig.Custom.Stacking.Mode = graph.StackSeries.ValueString()
 		}
+
+		if !graph.Transform.IsNull() {
+			fieldConfig.Custom.Transform = graph.Transform.ValueString()
+		}
+
+		if !graph.ThresholdsStyle.IsNull() {
+			fieldConfig.Custom.ThresholdsStyle.Mode = graph.ThresholdsStyle.ValueString()
+		}
+
+		if !graph.BarAlignment.IsNull() {
+			fieldConfig.Custom.BarAlignment = barAlignments[graph.BarAlignment.ValueString()]
+		}
+
+		if !graph.BarWidthFactor.IsNull() {
+			barWidthFactor := graph.BarWidthFactor.ValueFloat64()
+			fieldConfig.Custom.BarWidthFactor = &barWidthFactor
+		}
+
+		if !graph.BarMaxWidth.IsNull() {
+			barMaxWidth := int(graph.BarMaxWidth.ValueInt64())
+			fieldConfig.Custom.BarMaxWidth = &barMaxWidth
+		}
+
+		if !graph.InsertNulls.IsNull() {
+			insertNulls := int(graph.InsertNulls.ValueInt64())
+			fieldConfig.Custom.InsertNulls = &insertNulls
+		}
 	}
 
 	panel := &grafana.Panel{
@@ -445,7 +763,7 @@ func (d *TimeseriesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 			},
 			FieldConfig: grafana.FieldConfig{
 				Defaults:  fieldConfig,
-				Overrides: createOverrides(data.Overrides),
+				Overrides: createOverrides(data.Overrides, createTimeseriesOverrideProperties),
 			},
 		},
 	}
@@ -455,6 +773,15 @@ func (d *TimeseriesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		panel.CommonPanel.Description = &description
 	}
 
+	updateQueryOptions(&panel.CommonPanel, d.Defaults.QueryOptions, data.QueryOptions)
+
+	panel.CommonPanel.Transformations = createTransformations(data.Transformations, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	panel.CommonPanel.Links = createPanelLinks(d.Defaults.Panel, data.Links)
+
 	jsonData, err := json.MarshalIndent(panel, "", "  ")
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
@@ -469,3 +796,190 @@ func (d *TimeseriesDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func createTimeseriesOverrideProperties(fieldOptions []TimeseriesFieldOptions) []grafana.FieldOverrideProperty {
+	properties := make([]grafana.FieldOverrideProperty, 0)
+
+	for _, field := range fieldOptions {
+		properties = append(properties, createOverrideProperties([]FieldOptions{field.fieldOptions()})...)
+
+		for _, axis := range field.Axis {
+			if !axis.Label.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id:    "custom.axisLabel",
+					Value: axis.Label.ValueString(),
+				})
+			}
+
+			if !axis.Placement.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id:    "custom.axisPlacement",
+					Value: axis.Placement.ValueString(),
+				})
+			}
+
+			if !axis.SoftMin.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id:    "custom.axisSoftMin",
+					Value: axis.SoftMin.ValueInt64(),
+				})
+			}
+
+			if !axis.SoftMax.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id:    "custom.axisSoftMax",
+					Value: axis.SoftMax.ValueInt64(),
+				})
+			}
+
+			for _, scale := range axis.Scale {
+				scaleDistribution := grafana.ScaleDistribution{
+					Type: scale.Type.ValueString(),
+				}
+
+				if !scale.Log.IsNull() {
+					scaleDistribution.Log = int(scale.Log.ValueInt64())
+				}
+
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id:    "custom.scaleDistribution",
+					Value: scaleDistribution,
+				})
+			}
+		}
+
+		for _, graph := range field.Graph {
+			if !graph.DrawStyle.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id:    "custom.drawStyle",
+					Value: graph.DrawStyle.ValueString(),
+				})
+			}
+
+			if !graph.LineInterpolation.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id:    "custom.lineInterpolation",
+					Value: graph.LineInterpolation.ValueString(),
+				})
+			}
+
+			if !graph.LineWidth.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id:    "custom.lineWidth",
+					Value: graph.LineWidth.ValueInt64(),
+				})
+			}
+
+			if !graph.FillOpacity.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id:    "custom.fillOpacity",
+					Value: graph.FillOpacity.ValueInt64(),
+				})
+			}
+
+			if !graph.GradientMode.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id:    "custom.gradientMode",
+					Value: graph.GradientMode.ValueString(),
+				})
+			}
+
+			if !graph.LineStyle.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id: "custom.lineStyle",
+					Value: grafana.LineStyle{
+						Fill: graph.LineStyle.ValueString(),
+					},
+				})
+			}
+
+			if !graph.SpanNulls.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id:    "custom.spanNulls",
+					Value: graph.SpanNulls.ValueBool(),
+				})
+			}
+
+			if !graph.ShowPoints.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id:    "custom.showPoints",
+					Value: graph.ShowPoints.ValueString(),
+				})
+			}
+
+			if !graph.PointSize.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id:    "custom.pointSize",
+					Value: graph.PointSize.ValueInt64(),
+				})
+			}
+
+			if !graph.StackSeries.IsNull() {
+				// the group is not configurable, Grafana falls back to the default group when it is omitted
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id: "custom.stacking",
+					Value: map[string]interface{}{
+						"mode": graph.StackSeries.ValueString(),
+					},
+				})
+			}
+
+			if !graph.Transform.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id:    "custom.transform",
+					Value: graph.Transform.ValueString(),
+				})
+			}
+
+			if !graph.ThresholdsStyle.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id: "custom.thresholdsStyle",
+					Value: grafana.ThresholdsStyle{
+						Mode: graph.ThresholdsStyle.ValueString(),
+					},
+				})
+			}
+
+			if !graph.BarAlignment.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id:    "custom.barAlignment",
+					Value: barAlignments[graph.BarAlignment.ValueString()],
+				})
+			}
+
+			if !graph.BarWidthFactor.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id:    "custom.barWidthFactor",
+					Value: graph.BarWidthFactor.ValueFloat64(),
+				})
+			}
+
+			if !graph.BarMaxWidth.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id:    "custom.barMaxWidth",
+					Value: graph.BarMaxWidth.ValueInt64(),
+				})
+			}
+
+			if !graph.InsertNulls.IsNull() {
+				properties = append(properties, grafana.FieldOverrideProperty{
+					Id:    "custom.insertNulls",
+					Value: graph.InsertNulls.ValueInt64(),
+				})
+			}
+		}
+
+		for _, hideFrom := range field.HideFrom {
+			properties = append(properties, grafana.FieldOverrideProperty{
+				Id: "custom.hideFrom",
+				Value: grafana.HideFrom{
+					Legend:  hideFrom.Legend.ValueBool(),
+					Tooltip: hideFrom.Tooltip.ValueBool(),
+					Viz:     hideFrom.Viz.ValueBool(),
+				},
+			})
+		}
+	}
+
+	return properties
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -26,6 +27,20 @@ func TestAccTimeseriesDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.gdashboard_timeseries.test", "json", testAccTimeseriesDataSourceProviderCustomDefaultsConfigExpectedJson),
 				),
 			},
+			{
+				Config: testAccTimeseriesDataSourceProviderLegendTooltipDefaultsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_timeseries.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_timeseries.test", "json", testAccTimeseriesDataSourceProviderLegendTooltipDefaultsConfigExpectedJson),
+				),
+			},
+			{
+				Config: testAccTimeseriesDataSourceDatasourceVariableConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_timeseries.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_timeseries.test", "json", testAccTimeseriesDataSourceDatasourceVariableConfigExpectedJson),
+				),
+			},
 			{
 				Config: testAccTimeseriesDataSourceProviderDefaultsConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -33,6 +48,71 @@ func TestAccTimeseriesDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.gdashboard_timeseries.test", "json", testAccTimeseriesDataSourceProviderDefaultsConfigExpectedJson),
 				),
 			},
+			{
+				Config:      testAccTimeseriesDataSourceDuplicateRefIDConfig,
+				ExpectError: regexp.MustCompile("Duplicate Query Ref ID"),
+			},
+			{
+				Config:      testAccTimeseriesDataSourceUnknownRefIDConfig,
+				ExpectError: regexp.MustCompile("Unknown Query Ref ID"),
+			},
+			{
+				Config: testAccTimeseriesDataSourceUnknownQueryModelConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_timeseries.test", "title", "Test"),
+				),
+			},
+			{
+				Config:      testAccTimeseriesDataSourceEmptyOverrideConfig,
+				ExpectError: regexp.MustCompile("Missing Override Properties"),
+			},
+			{
+				Config:      testAccTimeseriesDataSourceInvalidUnitConfig,
+				ExpectError: regexp.MustCompile(`Did you mean "bytes"\?`),
+			},
+			{
+				Config: testAccTimeseriesDataSourceUnitCaseConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_timeseries.test", "title", "Test"),
+					resource.TestCheckResourceAttr("data.gdashboard_timeseries.test", "json", testAccTimeseriesDataSourceUnitCaseConfigExpectedJson),
+				),
+			},
+			{
+				Config:      testAccTimeseriesDataSourceInvalidColorConfig,
+				ExpectError: regexp.MustCompile(`Did you mean "semi-dark-red"\?`),
+			},
+			{
+				Config:      testAccTimeseriesDataSourceUnorderedThresholdsConfig,
+				ExpectError: regexp.MustCompile("The threshold steps must be in ascending order"),
+			},
+			{
+				Config:      testAccTimeseriesDataSourceMissingBaseThresholdConfig,
+				ExpectError: regexp.MustCompile("The first step defines the base color"),
+			},
+			{
+				Config:      testAccTimeseriesDataSourcePercentageThresholdConfig,
+				ExpectError: regexp.MustCompile("must be between 0 and 100, got: 120"),
+			},
+			{
+				Config:      testAccTimeseriesDataSourceInvalidMinMaxDefaultsConfig,
+				ExpectError: regexp.MustCompile("min must be less than max"),
+			},
+			{
+				Config:      testAccTimeseriesDataSourceInvalidSoftMinMaxConfig,
+				ExpectError: regexp.MustCompile("soft_min must be less than or equal to soft_max"),
+			},
+			{
+				Config:      testAccTimeseriesDataSourceInvalidRangeMappingConfig,
+				ExpectError: regexp.MustCompile("from must be less than or equal to to"),
+			},
+			{
+				Config:      testAccTimeseriesDataSourceReservedQueryModelKeyConfig,
+				ExpectError: regexp.MustCompile(`must not define the key "hide"`),
+			},
+			{
+				Config:      testAccTimeseriesDataSourceConflictingLegendFormatConfig,
+				ExpectError: regexp.MustCompile("legend_format can be used with the custom legend_mode only"),
+			},
 		},
 	})
 }
@@ -46,10 +126,15 @@ data "gdashboard_timeseries" "test" {
 	calculations = ["min", "max", "mean"]
 	display_mode = "table"
     placement    = "bottom"
+    sort_by      = "Max"
+    sort_desc    = true
   }
 
   tooltip {
-	mode = "multi"
+	mode       = "multi"
+    sort       = "desc"
+    max_height = 400
+    hide_zeros = true
   }
 
   field {
@@ -63,12 +148,109 @@ data "gdashboard_timeseries" "test" {
       fixed_color = "green"
       series_by   = "last"
     }
+
+    link {
+      title        = "Service dashboard"
+      url          = "https://grafana.example.com/d/service?var-instance=$${__field.labels.instance}"
+      target_blank = true
+    }
+
+    link {
+      title = "Traces"
+
+      internal {
+        datasource_uid  = "tempo"
+        datasource_name = "Tempo"
+        query           = jsonencode({
+          query     = "$${__value.raw}"
+          queryType = "traceql"
+        })
+      }
+    }
   }
 
   graph {
-    fill_opacity = 10
-    show_points  = "always"
-    span_nulls   = true
+    fill_opacity     = 10
+    show_points      = "always"
+    span_nulls       = true
+    thresholds_style = "line"
+    insert_nulls     = 3600000
+  }
+
+  overrides {
+    by_query_id {
+      query_id = "CW_Query"
+
+      field {
+        unit = "reqps"
+
+        axis {
+          label     = "Requests"
+          placement = "right"
+          soft_min  = 0
+
+          scale {
+            type = "log"
+            log  = 10
+          }
+        }
+
+        graph {
+          draw_style       = "bars"
+          bar_alignment    = "before"
+          bar_width_factor = 0.5
+          line_style       = "dash"
+          stack_series     = "normal"
+          transform        = "negative-Y"
+        }
+      }
+    }
+
+    by_name {
+      name = "Memory total"
+
+      field {
+        hide_from {
+          legend  = true
+          tooltip = true
+        }
+      }
+    }
+
+    by_names {
+      names = ["Memory total", "Request Count"]
+      mode  = "exclude"
+
+      field {
+        decimals = 0
+
+        graph {
+          thresholds_style = "off"
+        }
+      }
+    }
+
+    by_value {
+      reducer  = "max"
+      operator = "greater_or_equal"
+      value    = 1000
+
+      field {
+        color {
+          mode        = "fixed"
+          fixed_color = "red"
+        }
+      }
+    }
+  }
+
+  query_options {
+    max_data_points = 500
+    min_interval    = "1m"
+    relative_time   = "now-5m"
+    time_shift      = "1h"
+    hide_time_info  = true
+    cache_timeout   = "60"
   }
 
   queries {
@@ -81,6 +263,25 @@ data "gdashboard_timeseries" "test" {
       legend_format = "Memory total"
     }
 
+    prometheus {
+      uid             = "prometheus"
+      expr            = "sum(jvm_memory_used{container_name='container'})"
+      range           = true
+      instant         = true
+      exemplar        = true
+      hide            = true
+      editor_mode     = "builder"
+      interval_factor = 2
+      legend_mode     = "auto"
+    }
+
+    prometheus {
+      uid           = "prometheus"
+      expr          = "sum(jvm_memory_committed{container_name='container'})"
+      legend_mode   = "custom"
+      legend_format = "Memory committed"
+    }
+
 	cloudwatch {
 	  uid         = "cloudwatch"
 	  namespace   = "AWS/ApplicationELB"
@@ -112,6 +313,7 @@ const testAccTimeseriesDataSourceConfigExpectedJson = `{
   "editable": false,
   "error": false,
   "gridPos": {},
+  "hideTimeOverride": true,
   "id": 0,
   "isNew": true,
   "span": 12,
@@ -119,6 +321,11 @@ const testAccTimeseriesDataSourceConfigExpectedJson = `{
   "description": "Timeseries description",
   "transparent": false,
   "type": "timeseries",
+  "maxDataPoints": 500,
+  "interval": "1m",
+  "timeFrom": "now-5m",
+  "timeShift": "1h",
+  "cacheTimeout": "60",
   "targets": [
     {
       "refId": "Prometheus_Query",
@@ -139,6 +346,48 @@ const testAccTimeseriesDataSourceConfigExpectedJson = `{
       "interval": "30",
       "legendFormat": "Memory total"
     },
+    {
+      "refId": "A",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "prometheus",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "hide": true,
+      "expr": "sum(jvm_memory_used{container_name='container'})",
+      "intervalFactor": 2,
+      "legendFormat": "__auto",
+      "instant": true,
+      "range": true,
+      "exemplar": true,
+      "editorMode": "builder"
+    },
+    {
+      "refId": "B",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "prometheus",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "expr": "sum(jvm_memory_committed{container_name='container'})",
+      "legendFormat": "Memory committed"
+    },
     {
       "refId": "CW_Query",
       "datasource": {
@@ -176,10 +425,15 @@ const testAccTimeseriesDataSourceConfigExpectedJson = `{
         "mean"
       ],
       "displayMode": "table",
-      "placement": "bottom"
+      "placement": "bottom",
+      "sortBy": "Max",
+      "sortDesc": true
     },
     "tooltip": {
-      "mode": "multi"
+      "mode": "multi",
+      "sort": "desc",
+      "maxHeight": 400,
+      "hideZeros": true
     }
   },
   "fieldConfig": {
@@ -213,6 +467,7 @@ const testAccTimeseriesDataSourceConfigExpectedJson = `{
         "pointSize": 5,
         "showPoints": "always",
         "spanNulls": true,
+        "insertNulls": 3600000,
         "hideFrom": {
           "legend": false,
           "tooltip": false,
@@ -229,10 +484,149 @@ const testAccTimeseriesDataSourceConfigExpectedJson = `{
           "mode": "none"
         },
         "thresholdsStyle": {
-          "mode": ""
+          "mode": "line"
+        }
+      },
+      "links": [
+        {
+          "title": "Service dashboard",
+          "url": "https://grafana.example.com/d/service?var-instance=${__field.labels.instance}",
+          "targetBlank": true
+        },
+        {
+          "title": "Traces",
+          "url": "",
+          "internal": {
+            "datasourceUid": "tempo",
+            "datasourceName": "Tempo",
+            "query": {
+              "query": "${__value.raw}",
+              "queryType": "traceql"
+            }
+          }
         }
+      ]
+    },
+    "overrides": [
+      {
+        "matcher": {
+          "id": "byName",
+          "options": "Memory total"
+        },
+        "properties": [
+          {
+            "id": "custom.hideFrom",
+            "value": {
+              "legend": true,
+              "tooltip": true,
+              "viz": false
+            }
+          }
+        ]
+      },
+      {
+        "matcher": {
+          "id": "byFrameRefID",
+          "options": "CW_Query"
+        },
+        "properties": [
+          {
+            "id": "unit",
+            "value": "reqps"
+          },
+          {
+            "id": "custom.axisLabel",
+            "value": "Requests"
+          },
+          {
+            "id": "custom.axisPlacement",
+            "value": "right"
+          },
+          {
+            "id": "custom.axisSoftMin",
+            "value": 0
+          },
+          {
+            "id": "custom.scaleDistribution",
+            "value": {
+              "type": "log",
+              "log": 10
+            }
+          },
+          {
+            "id": "custom.drawStyle",
+            "value": "bars"
+          },
+          {
+            "id": "custom.lineStyle",
+            "value": {
+              "fill": "dash"
+            }
+          },
+          {
+            "id": "custom.stacking",
+            "value": {
+              "mode": "normal"
+            }
+          },
+          {
+            "id": "custom.transform",
+            "value": "negative-Y"
+          },
+          {
+            "id": "custom.barAlignment",
+            "value": -1
+          },
+          {
+            "id": "custom.barWidthFactor",
+            "value": 0.5
+          }
+        ]
+      },
+      {
+        "matcher": {
+          "id": "byNames",
+          "options": {
+            "mode": "exclude",
+            "names": [
+              "Memory total",
+              "Request Count"
+            ]
+          }
+        },
+        "properties": [
+          {
+            "id": "decimals",
+            "value": 0
+          },
+          {
+            "id": "custom.thresholdsStyle",
+            "value": {
+              "mode": "off"
+            }
+          }
+        ]
+      },
+      {
+        "matcher": {
+          "id": "byValue",
+          "options": {
+            "op": "gte",
+            "reducer": "max",
+            "value": 1000
+          }
+        },
+        "properties": [
+          {
+            "id": "color",
+            "value": {
+              "mode": "fixed",
+              "fixedColor": "red"
+            }
+          }
+        ]
       }
-    }
+    ]
   }
 }`
 
@@ -274,13 +668,43 @@ provider "gdashboard" {
         show_points  	   = "never"
         point_size   	   = 22
         stack_series   	   = "percent"
+        thresholds_style   = "dashed+area"
+        bar_alignment      = "after"
+        bar_width_factor   = 0.75
+        bar_max_width      = 20
+      }
+
+      query_options {
+        max_data_points = 100
+        min_interval    = "30s"
       }
 	}
+
+    queries {
+      prometheus {
+        exemplar    = true
+        editor_mode = "code"
+      }
+    }
   }
 }
 
 data "gdashboard_timeseries" "test" {
   title = "Test"
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "up"
+    }
+
+    prometheus {
+      uid         = "prometheus"
+      expr        = "down"
+      exemplar    = false
+      editor_mode = "builder"
+    }
+  }
 }
 `
 
@@ -294,6 +718,47 @@ const testAccTimeseriesDataSourceProviderCustomDefaultsConfigExpectedJson = `{
   "title": "Test",
   "transparent": false,
   "type": "timeseries",
+  "maxDataPoints": 100,
+  "interval": "30s",
+  "targets": [
+    {
+      "refId": "A",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "prometheus",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "expr": "up",
+      "exemplar": true,
+      "editorMode": "code"
+    },
+    {
+      "refId": "B",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "prometheus",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "expr": "down",
+      "editorMode": "builder"
+    }
+  ],
   "options": {
     "legend": {
       "calcs": [
@@ -330,7 +795,9 @@ const testAccTimeseriesDataSourceProviderCustomDefaultsConfigExpectedJson = `{
       },
       "custom": {
         "axisPlacement": "auto",
-        "barAlignment": 0,
+        "barAlignment": 1,
+        "barWidthFactor": 0.75,
+        "barMaxWidth": 20,
         "drawStyle": "bars",
         "fillOpacity": 30,
         "gradientMode": "hue",
@@ -355,20 +822,42 @@ const testAccTimeseriesDataSourceProviderCustomDefaultsConfigExpectedJson = `{
           "mode": "percent"
         },
         "thresholdsStyle": {
-          "mode": ""
+          "mode": "dashed+area"
         }
       }
     }
   }
 }`
 
-const testAccTimeseriesDataSourceProviderDefaultsConfig = `
+const testAccTimeseriesDataSourceProviderLegendTooltipDefaultsConfig = `
+provider "gdashboard" {
+  defaults {
+    timeseries {
+      legend {
+        placement   = "right"
+        show_legend = false
+        width       = 300
+        sort_by     = "Max"
+        sort_desc   = true
+      }
+
+      tooltip {
+        mode       = "multi"
+        sort       = "asc"
+        max_width  = 600
+        max_height = 400
+        hide_zeros = true
+      }
+    }
+  }
+}
+
 data "gdashboard_timeseries" "test" {
   title = "Test"
 }
 `
 
-const testAccTimeseriesDataSourceProviderDefaultsConfigExpectedJson = `{
+const testAccTimeseriesDataSourceProviderLegendTooltipDefaultsConfigExpectedJson = `{
   "editable": false,
   "error": false,
   "gridPos": {},
@@ -382,10 +871,18 @@ const testAccTimeseriesDataSourceProviderDefaultsConfigExpectedJson = `{
     "legend": {
       "calcs": null,
       "displayMode": "list",
-      "placement": "bottom"
+      "placement": "right",
+      "showLegend": false,
+      "width": 300,
+      "sortBy": "Max",
+      "sortDesc": true
     },
     "tooltip": {
-      "mode": "single"
+      "mode": "multi",
+      "sort": "asc",
+      "maxWidth": 600,
+      "maxHeight": 400,
+      "hideZeros": true
     }
   },
   "fieldConfig": {
@@ -432,9 +929,575 @@ const testAccTimeseriesDataSourceProviderDefaultsConfigExpectedJson = `{
           "mode": "none"
         },
         "thresholdsStyle": {
-          "mode": ""
+          "mode": "off"
         }
       }
     }
   }
 }`
+
+const testAccTimeseriesDataSourceDatasourceVariableConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  queries {
+    prometheus {
+      uid  = "$prometheus"
+      expr = "up"
+    }
+
+    cloudwatch {
+      uid         = "[[cloudwatch]]"
+      namespace   = "AWS/ApplicationELB"
+      metric_name = "HTTPCode_Target_2XX_Count"
+      statistic   = "Sum"
+    }
+  }
+}
+`
+
+const testAccTimeseriesDataSourceDatasourceVariableConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "timeseries",
+  "targets": [
+    {
+      "refId": "A",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "${prometheus}",
+        "name": "",
+        "type": "prometheus",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "expr": "up"
+    },
+    {
+      "refId": "B",
+      "datasource": {
+        "id": 0,
+        "orgId": 0,
+        "uid": "${cloudwatch}",
+        "name": "",
+        "type": "cloudwatch",
+        "typeLogoUrl": "",
+        "access": "",
+        "url": "",
+        "isDefault": false,
+        "jsonData": null,
+        "secureJsonData": null
+      },
+      "namespace": "AWS/ApplicationELB",
+      "metricName": "HTTPCode_Target_2XX_Count",
+      "statistics": [
+        "Sum"
+      ]
+    }
+  ],
+  "options": {
+    "legend": {
+      "calcs": null,
+      "displayMode": "list",
+      "placement": "bottom"
+    },
+    "tooltip": {
+      "mode": "single"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "auto",
+        "barAlignment": 0,
+        "drawStyle": "line",
+        "fillOpacity": 0,
+        "gradientMode": "none",
+        "lineInterpolation": "linear",
+        "lineWidth": 1,
+        "pointSize": 5,
+        "showPoints": "auto",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": "solid"
+        },
+        "scaleDistribution": {
+          "type": "linear"
+        },
+        "stacking": {
+          "group": "",
+          "mode": "none"
+        },
+        "thresholdsStyle": {
+          "mode": "off"
+        }
+      }
+    }
+  }
+}`
+
+const testAccTimeseriesDataSourceProviderDefaultsConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+}
+`
+
+const testAccTimeseriesDataSourceProviderDefaultsConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "timeseries",
+  "options": {
+    "legend": {
+      "calcs": null,
+      "displayMode": "list",
+      "placement": "bottom"
+    },
+    "tooltip": {
+      "mode": "single"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "auto",
+        "barAlignment": 0,
+        "drawStyle": "line",
+        "fillOpacity": 0,
+        "gradientMode": "none",
+        "lineInterpolation": "linear",
+        "lineWidth": 1,
+        "pointSize": 5,
+        "showPoints": "auto",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": "solid"
+        },
+        "scaleDistribution": {
+          "type": "linear"
+        },
+        "stacking": {
+          "group": "",
+          "mode": "none"
+        },
+        "thresholdsStyle": {
+          "mode": "off"
+        }
+      }
+    }
+  }
+}`
+
+const testAccTimeseriesDataSourceDuplicateRefIDConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  queries {
+    prometheus {
+      uid    = "prometheus"
+      expr   = "up"
+      ref_id = "Query"
+    }
+
+    prometheus {
+      uid    = "prometheus"
+      expr   = "down"
+      ref_id = "Query"
+    }
+  }
+}
+`
+
+const testAccTimeseriesDataSourceUnknownRefIDConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  overrides {
+    by_query_id {
+      query_id = "B"
+
+      field {
+        unit = "bytes"
+      }
+    }
+  }
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "up"
+    }
+  }
+}
+`
+
+const testAccTimeseriesDataSourceUnknownQueryModelConfig = `
+resource "terraform_data" "model" {
+  input = {
+    expr = "up"
+  }
+}
+
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  overrides {
+    by_query_id {
+      query_id = "B"
+
+      field {
+        unit = "bytes"
+      }
+    }
+  }
+
+  queries {
+    prometheus {
+      uid  = "prometheus"
+      expr = "up"
+    }
+
+    raw {
+      datasource_type = "prometheus"
+      model           = jsonencode(terraform_data.model.output)
+    }
+  }
+}
+`
+
+const testAccTimeseriesDataSourceEmptyOverrideConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  overrides {
+    by_name {
+      name = "Memory total"
+
+      field {
+        graph {}
+      }
+    }
+  }
+}
+`
+
+const testAccTimeseriesDataSourceInvalidUnitConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  field {
+    unit = "byte"
+  }
+}
+`
+
+const testAccTimeseriesDataSourceUnitCaseConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  field {
+    unit = " MS "
+  }
+
+  overrides {
+    by_name {
+      name = "Rate"
+
+      field {
+        unit = "Suffix:rps"
+      }
+    }
+  }
+}
+`
+
+const testAccTimeseriesDataSourceUnitCaseConfigExpectedJson = `{
+  "editable": false,
+  "error": false,
+  "gridPos": {},
+  "id": 0,
+  "isNew": true,
+  "span": 12,
+  "title": "Test",
+  "transparent": false,
+  "type": "timeseries",
+  "options": {
+    "legend": {
+      "calcs": null,
+      "displayMode": "list",
+      "placement": "bottom"
+    },
+    "tooltip": {
+      "mode": "single"
+    }
+  },
+  "fieldConfig": {
+    "defaults": {
+      "unit": "ms",
+      "color": {
+        "mode": "palette-classic",
+        "fixedColor": "green",
+        "seriesBy": "last"
+      },
+      "thresholds": {
+        "mode": "absolute",
+        "steps": [
+          {
+            "color": "green",
+            "value": null
+          }
+        ]
+      },
+      "custom": {
+        "axisPlacement": "auto",
+        "barAlignment": 0,
+        "drawStyle": "line",
+        "fillOpacity": 0,
+        "gradientMode": "none",
+        "lineInterpolation": "linear",
+        "lineWidth": 1,
+        "pointSize": 5,
+        "showPoints": "auto",
+        "spanNulls": false,
+        "hideFrom": {
+          "legend": false,
+          "tooltip": false,
+          "viz": false
+        },
+        "lineStyle": {
+          "fill": "solid"
+        },
+        "scaleDistribution": {
+          "type": "linear"
+        },
+        "stacking": {
+          "group": "",
+          "mode": "none"
+        },
+        "thresholdsStyle": {
+          "mode": "off"
+        }
+      }
+    },
+    "overrides": [
+      {
+        "matcher": {
+          "id": "byName",
+          "options": "Rate"
+        },
+        "properties": [
+          {
+            "id": "unit",
+            "value": "suffix:rps"
+          }
+        ]
+      }
+    ]
+  }
+}`
+
+const testAccTimeseriesDataSourceInvalidColorConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  field {
+    color {
+      mode        = "fixed"
+      fixed_color = "semi-dark-rde"
+    }
+  }
+}
+`
+
+const testAccTimeseriesDataSourceUnorderedThresholdsConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  field {
+    thresholds {
+      step {
+        color = "green"
+      }
+
+      step {
+        color = "red"
+        value = 90
+      }
+
+      step {
+        color = "orange"
+        value = 65
+      }
+    }
+  }
+}
+`
+
+const testAccTimeseriesDataSourcePercentageThresholdConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  field {
+    thresholds {
+      mode = "percentage"
+
+      step {
+        color = "green"
+      }
+
+      step {
+        color = "red"
+        value = 120
+      }
+    }
+  }
+}
+`
+
+const testAccTimeseriesDataSourceMissingBaseThresholdConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  field {
+    thresholds {
+      step {
+        color = "orange"
+        value = 65
+      }
+    }
+  }
+}
+`
+
+const testAccTimeseriesDataSourceInvalidMinMaxDefaultsConfig = `
+provider "gdashboard" {
+  defaults {
+    timeseries {
+      field {
+        min = 100
+        max = 0
+      }
+    }
+  }
+}
+
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+}
+`
+
+const testAccTimeseriesDataSourceInvalidSoftMinMaxConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  axis {
+    soft_min = 10
+    soft_max = 5
+  }
+}
+`
+
+const testAccTimeseriesDataSourceInvalidRangeMappingConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  field {
+    mappings {
+      range {
+        from         = 10
+        to           = 0
+        display_text = "Invalid"
+      }
+    }
+  }
+}
+`
+
+const testAccTimeseriesDataSourceReservedQueryModelKeyConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  queries {
+    raw {
+      datasource_uid  = "loki"
+      datasource_type = "loki"
+      ref_id          = "A"
+      model           = jsonencode({
+        expr = "count_over_time({container_name=\"container\"}[5m])"
+        hide = false
+      })
+    }
+  }
+}
+`
+
+const testAccTimeseriesDataSourceConflictingLegendFormatConfig = `
+data "gdashboard_timeseries" "test" {
+  title = "Test"
+
+  queries {
+    prometheus {
+      uid           = "prometheus"
+      expr          = "up"
+      legend_mode   = "verbose"
+      legend_format = "Up"
+    }
+  }
+}
+`
@@ -0,0 +1,900 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/iRevive/terraform-provider-gdashboard/internal/provider/grafana"
+)
+
+// Terraform projections
+
+type Transformations struct {
+	Organize          []OrganizeTransformation          `tfsdk:"organize"`
+	RenameByRegex     []RenameByRegexTransformation     `tfsdk:"rename_by_regex"`
+	Merge             []MergeTransformation             `tfsdk:"merge"`
+	JoinByField       []JoinByFieldTransformation       `tfsdk:"join_by_field"`
+	Reduce            []ReduceTransformation            `tfsdk:"reduce"`
+	FilterByName      []FilterByNameTransformation      `tfsdk:"filter_by_name"`
+	FilterByValue     []FilterByValueTransformation     `tfsdk:"filter_by_value"`
+	CalculateField    []CalculateFieldTransformation    `tfsdk:"calculate_field"`
+	GroupBy           []GroupByTransformation           `tfsdk:"group_by"`
+	SortBy            []SortByTransformation            `tfsdk:"sort_by"`
+	Limit             []LimitTransformation             `tfsdk:"limit"`
+	LabelsToFields    []LabelsToFieldsTransformation    `tfsdk:"labels_to_fields"`
+	PartitionByValues []PartitionByValuesTransformation `tfsdk:"partition_by_values"`
+	Raw               []RawTransformation               `tfsdk:"raw"`
+}
+
+type OrganizeTransformation struct {
+	Exclude []types.String          `tfsdk:"exclude"`
+	Order   []types.String          `tfsdk:"order"`
+	Rename  map[string]types.String `tfsdk:"rename"`
+}
+
+type RenameByRegexTransformation struct {
+	Regex         types.String `tfsdk:"regex"`
+	RenamePattern types.String `tfsdk:"rename_pattern"`
+}
+
+type MergeTransformation struct{}
+
+type JoinByFieldTransformation struct {
+	Field types.String `tfsdk:"field"`
+	Mode  types.String `tfsdk:"mode"`
+}
+
+type ReduceTransformation struct {
+	Calculations     []types.String `tfsdk:"calculations"`
+	Mode             types.String   `tfsdk:"mode"`
+	IncludeTimeField types.Bool     `tfsdk:"include_time_field"`
+	LabelsToFields   types.Bool     `tfsdk:"labels_to_fields"`
+}
+
+type FilterByNameTransformation struct {
+	Include      []types.String `tfsdk:"include"`
+	IncludeRegex types.String   `tfsdk:"include_regex"`
+	Exclude      []types.String `tfsdk:"exclude"`
+	ExcludeRegex types.String   `tfsdk:"exclude_regex"`
+}
+
+type FilterByValueTransformation struct {
+	Type       types.String             `tfsdk:"type"`
+	Match      types.String             `tfsdk:"match"`
+	Conditions []FilterByValueCondition `tfsdk:"condition"`
+}
+
+type FilterByValueCondition struct {
+	Field   types.String `tfsdk:"field"`
+	Matcher types.String `tfsdk:"matcher"`
+	Value   types.String `tfsdk:"value"`
+}
+
+type CalculateFieldTransformation struct {
+	Alias         types.String           `tfsdk:"alias"`
+	ReplaceFields types.Bool             `tfsdk:"replace_fields"`
+	ReduceRow     []CalculateFieldReduce `tfsdk:"reduce_row"`
+	Binary        []CalculateFieldBinary `tfsdk:"binary"`
+}
+
+type CalculateFieldReduce struct {
+	Calculation types.String   `tfsdk:"calculation"`
+	Fields      []types.String `tfsdk:"fields"`
+}
+
+type CalculateFieldBinary struct {
+	Left     types.String `tfsdk:"left"`
+	Operator types.String `tfsdk:"operator"`
+	Right    types.String `tfsdk:"right"`
+}
+
+type GroupByTransformation struct {
+	Fields []GroupByField `tfsdk:"field"`
+}
+
+type GroupByField struct {
+	Name         types.String   `tfsdk:"name"`
+	Operation    types.String   `tfsdk:"operation"`
+	Calculations []types.String `tfsdk:"calculations"`
+}
+
+type SortByTransformation struct {
+	Field types.String `tfsdk:"field"`
+	Desc  types.Bool   `tfsdk:"desc"`
+}
+
+type LimitTransformation struct {
+	Count types.Int64 `tfsdk:"count"`
+}
+
+type LabelsToFieldsTransformation struct {
+	Mode       types.String   `tfsdk:"mode"`
+	ValueLabel types.String   `tfsdk:"value_label"`
+	KeepLabels []types.String `tfsdk:"keep_labels"`
+}
+
+type PartitionByValuesTransformation struct {
+	Fields []types.String `tfsdk:"fields"`
+}
+
+type RawTransformation struct {
+	Id      types.String `tfsdk:"id"`
+	Options types.String `tfsdk:"options"`
+}
+
+// blocks
+
+func transformationsBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The transformations to apply to the query results before the visualization. " +
+			"The blocks are applied in order, each block must define exactly one transformation.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"organize": schema.ListNestedBlock{
+					Description: "Reorder, hide, or rename fields.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"exclude": schema.ListAttribute{
+								Optional:    true,
+								ElementType: types.StringType,
+								Description: "The names of the fields to hide.",
+							},
+							"order": schema.ListAttribute{
+								Optional:    true,
+								ElementType: types.StringType,
+								Description: "The names of the fields in the order to display them.",
+							},
+							"rename": schema.MapAttribute{
+								Optional:    true,
+								ElementType: types.StringType,
+								Description: "The new names of the fields keyed by the original names.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"rename_by_regex": schema.ListNestedBlock{
+					Description: "Rename parts of the query results using a regular expression and a replacement pattern.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"regex": schema.StringAttribute{
+								Required:    true,
+								Description: "The regular expression to match the field names.",
+							},
+							"rename_pattern": schema.StringAttribute{
+								Required:            true,
+								Description:         "The new name of the field. The capturing groups can be referenced as $1, $2, etc.",
+								MarkdownDescription: "The new name of the field. The capturing groups can be referenced as `$1`, `$2`, etc.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"merge": schema.ListNestedBlock{
+					Description: "Merge many series or tables into a single table.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"join_by_field": schema.ListNestedBlock{
+					Description: "Join many series or tables into a single table by a field.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"field": schema.StringAttribute{
+								Optional:    true,
+								Description: "The name of the field to join on. Defaults to the time field.",
+							},
+							"mode": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The join mode. The choices are: outer, inner.",
+								MarkdownDescription: "The join mode. The choices are: `outer`, `inner`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("outer", "inner"),
+								},
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"reduce": schema.ListNestedBlock{
+					Description: "Reduce all rows or data points to a single value using a function like max, min, mean or last.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"calculations": schema.ListAttribute{
+								Required:    true,
+								ElementType: types.StringType,
+								Description: "The calculations to apply.",
+								MarkdownDescription: "The calculations to apply. " +
+									"See Grafana [documentation](https://grafana.com/docs/grafana/latest/panels-visualizations/calculation-types/) for the available choices.",
+								Validators: []validator.List{
+									listvalidator.ValueStringsAre(stringvalidator.OneOf(calculations...)),
+								},
+							},
+							"mode": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The reduce mode. The choices are: series_to_rows, reduce_fields.",
+								MarkdownDescription: "The reduce mode. The choices are: `series_to_rows`, `reduce_fields`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("series_to_rows", "reduce_fields"),
+								},
+							},
+							"include_time_field": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to reduce the time field too or not.",
+							},
+							"labels_to_fields": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to convert the labels to fields or not.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"filter_by_name": schema.ListNestedBlock{
+					Description: "Remove portions of the query results by the field names.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"include": schema.ListAttribute{
+								Optional:    true,
+								ElementType: types.StringType,
+								Description: "The names of the fields to keep.",
+							},
+							"include_regex": schema.StringAttribute{
+								Optional:    true,
+								Description: "The regular expression to match the names of the fields to keep.",
+							},
+							"exclude": schema.ListAttribute{
+								Optional:    true,
+								ElementType: types.StringType,
+								Description: "The names of the fields to remove.",
+							},
+							"exclude_regex": schema.StringAttribute{
+								Optional:    true,
+								Description: "The regular expression to match the names of the fields to remove.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"filter_by_value": schema.ListNestedBlock{
+					Description: "Filter the rows of the query results by the field values.",
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"condition": schema.ListNestedBlock{
+								Description: "The condition to match the rows.",
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"field": schema.StringAttribute{
+											Required:    true,
+											Description: "The name of the field to check.",
+										},
+										"matcher": schema.StringAttribute{
+											Required: true,
+											Description: "The matcher to check the value with. The choices are: greater, greater_or_equal, lower, lower_or_equal, " +
+												"equal, not_equal, is_null, is_not_null, regex.",
+											MarkdownDescription: "The matcher to check the value with. The choices are: `greater`, `greater_or_equal`, `lower`, `lower_or_equal`, " +
+												"`equal`, `not_equal`, `is_null`, `is_not_null`, `regex`.",
+											Validators: []validator.String{
+												stringvalidator.OneOf(
+													"greater", "greater_or_equal", "lower", "lower_or_equal",
+													"equal", "not_equal", "is_null", "is_not_null", "regex",
+												),
+											},
+										},
+										"value": schema.StringAttribute{
+											Optional:    true,
+											Description: "The value to compare with. Not used by is_null and is_not_null matchers.",
+										},
+									},
+								},
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
+								},
+							},
+						},
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Whether to keep or remove the matching rows. The choices are: include, exclude.",
+								MarkdownDescription: "Whether to keep or remove the matching rows. The choices are: `include`, `exclude`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("include", "exclude"),
+								},
+							},
+							"match": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Whether a row must match any or all the conditions. The choices are: any, all.",
+								MarkdownDescription: "Whether a row must match any or all the conditions. The choices are: `any`, `all`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("any", "all"),
+								},
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"calculate_field": schema.ListNestedBlock{
+					Description: "Create a new field calculated from other fields.",
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"reduce_row": schema.ListNestedBlock{
+								Description: "Apply a calculation to each row of the selected fields.",
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"calculation": schema.StringAttribute{
+											Required:            true,
+											Description:         "The calculation to apply. The choices are: " + strings.Join(calculations, ", ") + ".",
+											MarkdownDescription: "The calculation to apply. The choices are: `" + strings.Join(calculations, "`, `") + "`.",
+											Validators: []validator.String{
+												stringvalidator.OneOf(calculations...),
+											},
+										},
+										"fields": schema.ListAttribute{
+											Optional:    true,
+											ElementType: types.StringType,
+											Description: "The names of the fields to use. Defaults to all number fields.",
+										},
+									},
+								},
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+							},
+							"binary": schema.ListNestedBlock{
+								Description: "Apply a math operation to two fields or values.",
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"left": schema.StringAttribute{
+											Required:    true,
+											Description: "The name of the field or the number on the left side of the operation.",
+										},
+										"operator": schema.StringAttribute{
+											Required:            true,
+											Description:         "The operator. The choices are: +, -, *, /.",
+											MarkdownDescription: "The operator. The choices are: `+`, `-`, `*`, `/`.",
+											Validators: []validator.String{
+												stringvalidator.OneOf("+", "-", "*", "/"),
+											},
+										},
+										"right": schema.StringAttribute{
+											Required:    true,
+											Description: "The name of the field or the number on the right side of the operation.",
+										},
+									},
+								},
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
+							},
+						},
+						Attributes: map[string]schema.Attribute{
+							"alias": schema.StringAttribute{
+								Optional:    true,
+								Description: "The name of the new field.",
+							},
+							"replace_fields": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to hide all the other fields or not.",
+							},
+						},
+						Validators: []validator.Object{
+							exactlyOneBlock("reduce_row", "binary"),
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"group_by": schema.ListNestedBlock{
+					Description: "Group the data by a field value and calculate the aggregations of the other fields.",
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"field": schema.ListNestedBlock{
+								Description: "The field to group by or to aggregate.",
+								NestedObject: schema.NestedBlockObject{
+									Attributes: map[string]schema.Attribute{
+										"name": schema.StringAttribute{
+											Required:    true,
+											Description: "The name of the field.",
+										},
+										"operation": schema.StringAttribute{
+											Required:            true,
+											Description:         "What to do with the field. The choices are: group_by, aggregate.",
+											MarkdownDescription: "What to do with the field. The choices are: `group_by`, `aggregate`.",
+											Validators: []validator.String{
+												stringvalidator.OneOf("group_by", "aggregate"),
+											},
+										},
+										"calculations": schema.ListAttribute{
+											Optional:            true,
+											ElementType:         types.StringType,
+											Description:         "The calculations to aggregate the field with. The choices are: " + strings.Join(calculations, ", ") + ".",
+											MarkdownDescription: "The calculations to aggregate the field with. The choices are: `" + strings.Join(calculations, "`, `") + "`.",
+											Validators: []validator.List{
+												listvalidator.ValueStringsAre(stringvalidator.OneOf(calculations...)),
+											},
+										},
+									},
+								},
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
+								},
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"sort_by": schema.ListNestedBlock{
+					Description: "Sort the query results by a field.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"field": schema.StringAttribute{
+								Required:    true,
+								Description: "The name of the field to sort by.",
+							},
+							"desc": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to sort in descending order or not.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"limit": schema.ListNestedBlock{
+					Description: "Limit the number of rows displayed.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"count": schema.Int64Attribute{
+								Required:    true,
+								Description: "The maximum number of rows.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"labels_to_fields": schema.ListNestedBlock{
+					Description: "Convert the time series labels to fields.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"mode": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Whether to convert the labels to columns or rows. The choices are: columns, rows.",
+								MarkdownDescription: "Whether to convert the labels to columns or rows. The choices are: `columns`, `rows`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("columns", "rows"),
+								},
+							},
+							"value_label": schema.StringAttribute{
+								Optional:    true,
+								Description: "The label to use as the field name.",
+							},
+							"keep_labels": schema.ListAttribute{
+								Optional:    true,
+								ElementType: types.StringType,
+								Description: "The labels to keep. Defaults to all labels.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"partition_by_values": schema.ListNestedBlock{
+					Description: "Split a single query result into many series by the values of the fields.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"fields": schema.ListAttribute{
+								Required:    true,
+								ElementType: types.StringType,
+								Description: "The names of the fields to partition by.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+				"raw": schema.ListNestedBlock{
+					Description: "Any other transformation.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"id": schema.StringAttribute{
+								Required:            true,
+								Description:         "The ID of the transformation, e.g. seriesToRows.",
+								MarkdownDescription: "The ID of the transformation, e.g. `seriesToRows`.",
+							},
+							"options": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The JSON-encoded options of the transformation.",
+								MarkdownDescription: "The JSON-encoded options of the transformation. Example: `jsonencode({ mode = \"columns\" })`",
+								Validators: []validator.String{
+									jsonObject(),
+								},
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+			},
+			Validators: []validator.Object{
+				exactlyOneBlock(
+					"organize", "rename_by_regex", "merge", "join_by_field", "reduce", "filter_by_name", "filter_by_value",
+					"calculate_field", "group_by", "sort_by", "limit", "labels_to_fields", "partition_by_values", "raw",
+				),
+			},
+		},
+	}
+}
+
+// creators
+
+func createTransformations(transformations []Transformations, diagnostics *diag.Diagnostics) []grafana.Transformation {
+	result := make([]grafana.Transformation, 0)
+
+	for _, group := range transformations {
+		for _, organize := range group.Organize {
+			excludeByName := make(map[string]interface{})
+			indexByName := make(map[string]interface{})
+			renameByName := make(map[string]interface{})
+
+			for _, name := range organize.Exclude {
+				excludeByName[name.ValueString()] = true
+			}
+
+			for i, name := range organize.Order {
+				indexByName[name.ValueString()] = i
+			}
+
+			for name, rename := range organize.Rename {
+				renameByName[name] = rename.ValueString()
+			}
+
+			result = append(result, grafana.Transformation{
+				ID: "organize",
+				Options: map[string]interface{}{
+					"excludeByName": excludeByName,
+					"indexByName":   indexByName,
+					"renameByName":  renameByName,
+				},
+			})
+		}
+
+		for _, rename := range group.RenameByRegex {
+			result = append(result, grafana.Transformation{
+				ID: "renameByRegex",
+				Options: map[string]interface{}{
+					"regex":         rename.Regex.ValueString(),
+					"renamePattern": rename.RenamePattern.ValueString(),
+				},
+			})
+		}
+
+		for range group.Merge {
+			result = append(result, grafana.Transformation{
+				ID:      "merge",
+				Options: map[string]interface{}{},
+			})
+		}
+
+		for _, join := range group.JoinByField {
+			options := make(map[string]interface{})
+
+			if !join.Field.IsNull() {
+				options["byField"] = join.Field.ValueString()
+			}
+
+			if !join.Mode.IsNull() {
+				options["mode"] = join.Mode.ValueString()
+			}
+
+			result = append(result, grafana.Transformation{
+				ID:      "joinByField",
+				Options: options,
+			})
+		}
+
+		for _, reduce := range group.Reduce {
+			options := map[string]interface{}{
+				"reducers": stringValues(reduce.Calculations),
+			}
+
+			switch reduce.Mode.ValueString() {
+			case "series_to_rows":
+				options["mode"] = "seriesToRows"
+			case "reduce_fields":
+				options["mode"] = "reduceFields"
+			}
+
+			if !reduce.IncludeTimeField.IsNull() {
+				options["includeTimeField"] = reduce.IncludeTimeField.ValueBool()
+			}
+
+			if !reduce.LabelsToFields.IsNull() {
+				options["labelsToFields"] = reduce.LabelsToFields.ValueBool()
+			}
+
+			result = append(result, grafana.Transformation{
+				ID:      "reduce",
+				Options: options,
+			})
+		}
+
+		for _, filter := range group.FilterByName {
+			options := make(map[string]interface{})
+
+			if include := createNamesMatcher(filter.Include, filter.IncludeRegex); include != nil {
+				options["include"] = include
+			}
+
+			if exclude := createNamesMatcher(filter.Exclude, filter.ExcludeRegex); exclude != nil {
+				options["exclude"] = exclude
+			}
+
+			result = append(result, grafana.Transformation{
+				ID:      "filterFieldsByName",
+				Options: options,
+			})
+		}
+
+		for _, filter := range group.FilterByValue {
+			filters := make([]interface{}, len(filter.Conditions))
+
+			for i, condition := range filter.Conditions {
+				filters[i] = map[string]interface{}{
+					"fieldName": condition.Field.ValueString(),
+					"config":    createValueMatcher(condition),
+				}
+			}
+
+			options := map[string]interface{}{
+				"type":    "include",
+				"match":   "any",
+				"filters": filters,
+			}
+
+			if !filter.Type.IsNull() {
+				options["type"] = filter.Type.ValueString()
+			}
+
+			if !filter.Match.IsNull() {
+				options["match"] = filter.Match.ValueString()
+			}
+
+			result = append(result, grafana.Transformation{
+				ID:      "filterByValue",
+				Options: options,
+			})
+		}
+
+		for _, calculate := range group.CalculateField {
+			options := make(map[string]interface{})
+
+			for _, reduce := range calculate.ReduceRow {
+				reducer := map[string]interface{}{
+					"reducer": reduce.Calculation.ValueString(),
+				}
+
+				if reduce.Fields != nil {
+					reducer["include"] = stringValues(reduce.Fields)
+				}
+
+				options["mode"] = "reduceRow"
+				options["reduce"] = reducer
+			}
+
+			for _, binary := range calculate.Binary {
+				options["mode"] = "binary"
+				options["binary"] = map[string]interface{}{
+					"left":     binary.Left.ValueString(),
+					"operator": binary.Operator.ValueString(),
+					"right":    binary.Right.ValueString(),
+				}
+			}
+
+			if !calculate.Alias.IsNull() {
+				options["alias"] = calculate.Alias.ValueString()
+			}
+
+			if !calculate.ReplaceFields.IsNull() {
+				options["replaceFields"] = calculate.ReplaceFields.ValueBool()
+			}
+
+			result = append(result, grafana.Transformation{
+				ID:      "calculateField",
+				Options: options,
+			})
+		}
+
+		for _, groupBy := range group.GroupBy {
+			fields := make(map[string]interface{})
+
+			for _, field := range groupBy.Fields {
+				operation := "groupby"
+
+				if field.Operation.ValueString() == "aggregate" {
+					operation = "aggregate"
+				}
+
+				fields[field.Name.ValueString()] = map[string]interface{}{
+					"operation":    operation,
+					"aggregations": stringValues(field.Calculations),
+				}
+			}
+
+			result = append(result, grafana.Transformation{
+				ID: "groupBy",
+				Options: map[string]interface{}{
+					"fields": fields,
+				},
+			})
+		}
+
+		for _, sortBy := range group.SortBy {
+			result = append(result, grafana.Transformation{
+				ID: "sortBy",
+				Options: map[string]interface{}{
+					"sort": []interface{}{
+						map[string]interface{}{
+							"field": sortBy.Field.ValueString(),
+							"desc":  sortBy.Desc.ValueBool(),
+						},
+					},
+				},
+			})
+		}
+
+		for _, limit := range group.Limit {
+			result = append(result, grafana.Transformation{
+				ID: "limit",
+				Options: map[string]interface{}{
+					"limitField": limit.Count.ValueInt64(),
+				},
+			})
+		}
+
+		for _, labels := range group.LabelsToFields {
+			options := make(map[string]interface{})
+
+			if !labels.Mode.IsNull() {
+				options["mode"] = labels.Mode.ValueString()
+			}
+
+			if !labels.ValueLabel.IsNull() {
+				options["valueLabel"] = labels.ValueLabel.ValueString()
+			}
+
+			if labels.KeepLabels != nil {
+				options["keepLabels"] = stringValues(labels.KeepLabels)
+			}
+
+			result = append(result, grafana.Transformation{
+				ID:      "labelsToFields",
+				Options: options,
+			})
+		}
+
+		for _, partition := range group.PartitionByValues {
+			result = append(result, grafana.Transformation{
+				ID: "partitionByValues",
+				Options: map[string]interface{}{
+					"fields": stringValues(partition.Fields),
+				},
+			})
+		}
+
+		for _, raw := range group.Raw {
+			options := make(map[string]interface{})
+
+			if !raw.Options.IsNull() {
+				if err := json.Unmarshal([]byte(raw.Options.ValueString()), &options); err != nil {
+					diagnostics.AddError("Client Error", fmt.Sprintf("Could not unmarshall the transformation options: %s", err))
+					return nil
+				}
+			}
+
+			result = append(result, grafana.Transformation{
+				ID:      raw.Id.ValueString(),
+				Options: options,
+			})
+		}
+	}
+
+	return result
+}
+
+func createNamesMatcher(names []types.String, regex types.String) map[string]interface{} {
+	if names == nil && regex.IsNull() {
+		return nil
+	}
+
+	matcher := make(map[string]interface{})
+
+	if names != nil {
+		matcher["names"] = stringValues(names)
+	}
+
+	if !regex.IsNull() {
+		matcher["pattern"] = regex.ValueString()
+	}
+
+	return matcher
+}
+
+func createValueMatcher(condition FilterByValueCondition) map[string]interface{} {
+	ids := map[string]string{
+		"greater":          "greater",
+		"greater_or_equal": "greaterOrEqual",
+		"lower":            "lower",
+		"lower_or_equal":   "lowerOrEqual",
+		"equal":            "equal",
+		"not_equal":        "notEqual",
+		"is_null":          "isNull",
+		"is_not_null":      "isNotNull",
+		"regex":            "regex",
+	}
+
+	matcher := condition.Matcher.ValueString()
+	options := make(map[string]interface{})
+
+	if !condition.Value.IsNull() {
+		value := condition.Value.ValueString()
+		options["value"] = value
+
+		// comparisons are numeric in Grafana
+		if matcher != "regex" {
+			if number, err := strconv.ParseFloat(value, 64); err == nil {
+				options["value"] = number
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"id":      ids[matcher],
+		"options": options,
+	}
+}
+
+func stringValues(values []types.String) []string {
+	result := make([]string, len(values))
+
+	for i, value := range values {
+		result[i] = value.ValueString()
+	}
+
+	return result
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"strings"
+)
+
+// units is the catalog of the units supported by Grafana.
+// See https://github.com/grafana/grafana/blob/main/packages/grafana-data/src/valueFormats/categories.ts.
+var units = []string{
+	// misc
+	"none", "string", "short", "sishort", "percent", "percentunit", "humidity", "dB", "candela", "hex0x", "hex", "sci", "locale", "pixel",
+	// acceleration
+	"accMS2", "accFS2", "accG",
+	// angle
+	"degree", "radian", "grad", "arcmin", "arcsec",
+	// area
+	"areaM2", "areaF2", "areaMI2", "areaacre", "areaha",
+	// boolean
+	"bool", "bool_yes_no", "bool_on_off",
+	// computation
+	"flops", "mflops", "gflops", "tflops", "pflops", "eflops", "zflops", "yflops",
+	// concentration
+	"ppm", "conppb", "conngm3", "conngNm3", "conμgm3", "conμgNm3", "conmgm3", "conmgNm3", "congm3", "congNm3", "conmgdL", "conmmolL",
+	// currency
+	"currencyUSD", "currencyGBP", "currencyEUR", "currencyJPY", "currencyRUB", "currencyUAH", "currencyBRL", "currencyDKK",
+	"currencyISK", "currencyNOK", "currencySEK", "currencyCZK", "currencyCHF", "currencyPLN", "currencyBTC", "currencymBTC",
+	"currencyμBTC", "currencyZAR", "currencyINR", "currencyKRW", "currencyIDR", "currencyPHP", "currencyVND",
+	// data
+	"bytes", "decbytes", "bits", "decbits", "kbytes", "deckbytes", "mbytes", "decmbytes", "gbytes", "decgbytes", "tbytes", "dectbytes", "pbytes", "decpbytes",
+	// data rate
+	"pps", "binBps", "Bps", "binbps", "bps", "KiBs", "Kibits", "KBs", "Kbits", "MiBs", "Mibits", "MBs", "Mbits",
+	"GiBs", "Gibits", "GBs", "Gbits", "TiBs", "Tibits", "TBs", "Tbits", "PiBs", "Pibits", "PBs", "Pbits",
+	// date & time
+	"dateTimeAsIso", "dateTimeAsIsoNoDateIfToday", "dateTimeAsUS", "dateTimeAsUSNoDateIfToday", "dateTimeAsLocal",
+	"dateTimeAsLocalNoDateIfToday", "dateTimeAsSystem", "dateTimeFromNow",
+	// energy
+	"watt", "kwatt", "megwatt", "gwatt", "mwatt", "Wm2", "voltamp", "kvoltamp", "voltampreact", "kvoltampreact",
+	"watth", "watthperkg", "kwatth", "kwattm", "mwatth", "Ah", "kAh", "mAh", "joule", "ev", "amp", "kamp", "mamp",
+	"volt", "kvolt", "mvolt", "dBm", "mohm", "ohm", "kohm", "Mohm", "farad", "µfarad", "nfarad", "pfarad", "ffarad",
+	"henry", "mhenry", "µhenry", "lumens",
+	// flow
+	"flowgpm", "flowcms", "flowcfs", "flowcfm", "litreh", "flowlpm", "flowmlpm", "lux",
+	// force
+	"forceNm", "forcekNm", "forceN", "forcekN",
+	// hash rate
+	"Hs", "KHs", "MHs", "GHs", "THs", "PHs", "EHs",
+	// mass
+	"massmg", "massg", "masslb", "masskg", "masst",
+	// length
+	"lengthmm", "lengthin", "lengthft", "lengthm", "lengthkm", "lengthmi",
+	// pressure
+	"pressurembar", "pressurebar", "pressurekbar", "pressurepa", "pressurehpa", "pressurekpa", "pressurehg", "pressurepsi",
+	// radiation
+	"radbq", "radci", "radgy", "radrad", "radsv", "radmsv", "radusv", "radrem", "radexpckg", "radr", "radsvh", "radmsvh", "radusvh",
+	// rotational speed
+	"rotrpm", "rothz", "rotrads", "rotdegs",
+	// temperature
+	"celsius", "fahrenheit", "kelvin",
+	// time
+	"hertz", "ns", "µs", "ms", "s", "m", "h", "d", "dtdurationms", "dtdurations", "dthms", "dtdhms", "timeticks", "clockms", "clocks",
+	// throughput
+	"cps", "ops", "reqps", "rps", "wps", "iops", "eps", "mps", "recps", "rowsps",
+	"cpm", "opm", "reqpm", "rpm", "wpm", "epm", "mpm", "recpm", "rowspm",
+	// velocity
+	"velocityms", "velocitykmh", "velocitymph", "velocityknot",
+	// volume
+	"mlitre", "litre", "m3", "Nm3", "dm3", "gallons",
+}
+
+// legacyUnits are accepted for compatibility with the existing configurations.
+// An empty unit leaves the choice to Grafana, the other ids are rendered by Grafana as a suffix, e.g. `p`.
+var legacyUnits = []string{"", "p"}
+
+// customUnitPrefixes are the prefixes of the custom units, e.g. `suffix:rps` or `currency:CAD`.
+var customUnitPrefixes = []string{"suffix:", "prefix:", "si:", "count:", "time:", "currency:"}
+
+// normalizeUnit trims the whitespace around the unit and restores the case of a known unit, e.g. ` MS ` becomes `ms`.
+// The case is kept when several known units differ by case only, e.g. `Bps` and `bps`.
+// The second value reports whether the unit is known.
+func normalizeUnit(unit string) (string, bool) {
+	unit = strings.TrimSpace(unit)
+
+	for _, prefix := range customUnitPrefixes {
+		if len(unit) > len(prefix) && strings.EqualFold(unit[:len(prefix)], prefix) {
+			return prefix + unit[len(prefix):], true
+		}
+	}
+
+	matches := make([]string, 0)
+
+	for _, catalog := range [][]string{legacyUnits, units} {
+		for _, known := range catalog {
+			if unit == known {
+				return known, true
+			}
+
+			if strings.EqualFold(unit, known) {
+				matches = append(matches, known)
+			}
+		}
+	}
+
+	if len(matches) == 1 {
+		return matches[0], true
+	}
+
+	return unit, false
+}
+
+func isKnownUnit(unit string) bool {
+	_, ok := normalizeUnit(unit)
+	return ok
+}
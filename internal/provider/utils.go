@@ -1,28 +1,40 @@
 package provider
 
 import (
+	"encoding/json"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/iRevive/terraform-provider-gdashboard/internal/provider/grafana"
 	"hash/crc32"
+	"net/url"
+	"regexp"
+	"strings"
 )
 
 // defaults
 
 type FieldDefaults struct {
-	Unit       string
-	Decimals   *int
-	Min        *float64
-	Max        *float64
-	NoValue    *float64
-	Color      ColorDefaults
-	Thresholds ThresholdDefaults
+	Unit        string
+	Decimals    *int
+	Min         *float64
+	Max         *float64
+	NoValue     *float64
+	DisplayName string
+	Description string
+	Filterable  *bool
+	FieldMinMax *bool
+	Color       ColorDefaults
+	Thresholds  ThresholdDefaults
+	Mappings    []grafana.FieldMapping
+	Links       []grafana.DataLink
 }
 
 func NewFieldDefaults() FieldDefaults {
@@ -97,6 +109,28 @@ type ScaleDefaults struct {
 	Log  int
 }
 
+type QueryOptionsDefaults struct {
+	MaxDataPoints *int
+	MinInterval   string
+	RelativeTime  string
+	TimeShift     string
+	HideTimeInfo  bool
+	CacheTimeout  string
+}
+
+type QueryDefaults struct {
+	Prometheus PrometheusDefaults
+}
+
+type PrometheusDefaults struct {
+	Exemplar   bool
+	EditorMode string
+}
+
+type PanelDefaults struct {
+	Links []grafana.DataLink
+}
+
 // Terraform projections
 
 type AxisOptions struct {
@@ -145,15 +179,32 @@ type SpecialMappingOptions struct {
 }
 
 type FieldOptions struct {
-	Unit       types.String       `tfsdk:"unit"`
-	Decimals   types.Int64        `tfsdk:"decimals"`
-	Min        types.Float64      `tfsdk:"min"`
-	Max        types.Float64      `tfsdk:"max"`
-	NoValue    types.Float64      `tfsdk:"no_value"`
-	Color      []ColorOptions     `tfsdk:"color"`
-	Mappings   []MappingOptions   `tfsdk:"mappings"`
-	Thresholds []ThresholdOptions `tfsdk:"thresholds"`
-	// todo links
+	Unit        types.String       `tfsdk:"unit"`
+	Decimals    types.Int64        `tfsdk:"decimals"`
+	Min         types.Float64      `tfsdk:"min"`
+	Max         types.Float64      `tfsdk:"max"`
+	NoValue     types.Float64      `tfsdk:"no_value"`
+	DisplayName types.String       `tfsdk:"display_name"`
+	Description types.String       `tfsdk:"description"`
+	Filterable  types.Bool         `tfsdk:"filterable"`
+	FieldMinMax types.Bool         `tfsdk:"field_min_max"`
+	Color       []ColorOptions     `tfsdk:"color"`
+	Mappings    []MappingOptions   `tfsdk:"mappings"`
+	Thresholds  []ThresholdOptions `tfsdk:"thresholds"`
+	Links       []DataLinkOptions  `tfsdk:"link"`
+}
+
+type DataLinkOptions struct {
+	Title       types.String              `tfsdk:"title"`
+	Url         types.String              `tfsdk:"url"`
+	TargetBlank types.Bool                `tfsdk:"target_blank"`
+	Internal    []DataLinkInternalOptions `tfsdk:"internal"`
+}
+
+type DataLinkInternalOptions struct {
+	DatasourceUid  types.String `tfsdk:"datasource_uid"`
+	DatasourceName types.String `tfsdk:"datasource_name"`
+	Query          types.String `tfsdk:"query"`
 }
 
 type ColorOptions struct {
@@ -184,47 +235,101 @@ type TextSizeOptions struct {
 	Value types.Int64 `tfsdk:"value"`
 }
 
-type FieldOverrideOptions struct {
-	ByName    []ByNameOverrideOptions    `tfsdk:"by_name"`
-	ByRegex   []ByRegexOverrideOptions   `tfsdk:"by_regex"`
-	ByType    []ByTypeOverrideOptions    `tfsdk:"by_type"`
-	ByQueryID []ByQueryIDOverrideOptions `tfsdk:"by_query_id"`
+// FieldOverrideOptions is parameterized by the field options of a panel,
+// so the panels can override the properties specific to their visualization.
+type FieldOverrideOptions[F any] struct {
+	ByName    []ByNameOverrideOptions[F]    `tfsdk:"by_name"`
+	ByRegex   []ByRegexOverrideOptions[F]   `tfsdk:"by_regex"`
+	ByType    []ByTypeOverrideOptions[F]    `tfsdk:"by_type"`
+	ByQueryID []ByQueryIDOverrideOptions[F] `tfsdk:"by_query_id"`
+	ByNames   []ByNamesOverrideOptions[F]   `tfsdk:"by_names"`
+	ByValue   []ByValueOverrideOptions[F]   `tfsdk:"by_value"`
+}
+
+type ByNameOverrideOptions[F any] struct {
+	Name  types.String `tfsdk:"name"`
+	Field []F          `tfsdk:"field"`
+}
+
+type ByRegexOverrideOptions[F any] struct {
+	Regex types.String `tfsdk:"regex"`
+	Field []F          `tfsdk:"field"`
 }
 
-type ByNameOverrideOptions struct {
-	Name  types.String   `tfsdk:"name"`
-	Field []FieldOptions `tfsdk:"field"`
+type ByTypeOverrideOptions[F any] struct {
+	Type  types.String `tfsdk:"type"`
+	Field []F          `tfsdk:"field"`
 }
 
-type ByRegexOverrideOptions struct {
-	Regex types.String   `tfsdk:"regex"`
-	Field []FieldOptions `tfsdk:"field"`
+type ByQueryIDOverrideOptions[F any] struct {
+	QueryID types.String `tfsdk:"query_id"`
+	Field   []F          `tfsdk:"field"`
 }
 
-type ByTypeOverrideOptions struct {
-	Type  types.String   `tfsdk:"type"`
-	Field []FieldOptions `tfsdk:"field"`
+type ByNamesOverrideOptions[F any] struct {
+	Names []types.String `tfsdk:"names"`
+	Mode  types.String   `tfsdk:"mode"`
+	Field []F            `tfsdk:"field"`
 }
 
-type ByQueryIDOverrideOptions struct {
-	QueryID types.String   `tfsdk:"query_id"`
-	Field   []FieldOptions `tfsdk:"field"`
+type ByValueOverrideOptions[F any] struct {
+	Reducer  types.String  `tfsdk:"reducer"`
+	Operator types.String  `tfsdk:"operator"`
+	Value    types.Float64 `tfsdk:"value"`
+	Field    []F           `tfsdk:"field"`
+}
+
+type QueryOptions struct {
+	MaxDataPoints types.Int64  `tfsdk:"max_data_points"`
+	MinInterval   types.String `tfsdk:"min_interval"`
+	RelativeTime  types.String `tfsdk:"relative_time"`
+	TimeShift     types.String `tfsdk:"time_shift"`
+	HideTimeInfo  types.Bool   `tfsdk:"hide_time_info"`
+	CacheTimeout  types.String `tfsdk:"cache_timeout"`
 }
 
 type Query struct {
 	Prometheus []PrometheusTarget `tfsdk:"prometheus"`
 	CloudWatch []CloudWatchTarget `tfsdk:"cloudwatch"`
+	Raw        []RawTarget        `tfsdk:"raw"`
 }
 
 type PrometheusTarget struct {
-	Uid     types.String `tfsdk:"uid"`
-	Expr    types.String `tfsdk:"expr"`
-	Instant types.Bool   `tfsdk:"instant"`
-	Format  types.String `tfsdk:"format"`
+	Uid        types.String `tfsdk:"uid"`
+	Expr       types.String `tfsdk:"expr"`
+	Instant    types.Bool   `tfsdk:"instant"`
+	Range      types.Bool   `tfsdk:"range"`
+	Exemplar   types.Bool   `tfsdk:"exemplar"`
+	Format     types.String `tfsdk:"format"`
+	Hide       types.Bool   `tfsdk:"hide"`
+	EditorMode types.String `tfsdk:"editor_mode"`
 	// etc
-	RefId        types.String `tfsdk:"ref_id"`
-	MinInterval  types.String `tfsdk:"min_interval"`
-	LegendFormat types.String `tfsdk:"legend_format"`
+	RefId          types.String `tfsdk:"ref_id"`
+	MinInterval    types.String `tfsdk:"min_interval"`
+	IntervalFactor types.Int64  `tfsdk:"interval_factor"`
+	LegendMode     types.String `tfsdk:"legend_mode"`
+	LegendFormat   types.String `tfsdk:"legend_format"`
+}
+
+type QueryDefaultsOptions struct {
+	Prometheus []PrometheusDefaultsOptions `tfsdk:"prometheus"`
+}
+
+type PrometheusDefaultsOptions struct {
+	Exemplar   types.Bool   `tfsdk:"exemplar"`
+	EditorMode types.String `tfsdk:"editor_mode"`
+}
+
+type PanelDefaultsOptions struct {
+	Links []PanelLinkOptions `tfsdk:"links"`
+}
+
+type PanelLinkOptions struct {
+	Title            types.String `tfsdk:"title"`
+	Url              types.String `tfsdk:"url"`
+	TargetBlank      types.Bool   `tfsdk:"target_blank"`
+	IncludeTimeRange types.Bool   `tfsdk:"include_time_range"`
+	IncludeVariables types.Bool   `tfsdk:"include_variables"`
 }
 
 type CloudWatchTarget struct {
@@ -241,6 +346,14 @@ type CloudWatchTarget struct {
 	Label  types.String `tfsdk:"label"`
 }
 
+type RawTarget struct {
+	DatasourceUid  types.String `tfsdk:"datasource_uid"`
+	DatasourceType types.String `tfsdk:"datasource_type"`
+	RefId          types.String `tfsdk:"ref_id"`
+	Hide           types.Bool   `tfsdk:"hide"`
+	Model          types.String `tfsdk:"model"`
+}
+
 type CloudWatchDimension struct {
 	Name  types.String `tfsdk:"name"`
 	Value types.String `tfsdk:"value"`
@@ -322,6 +435,9 @@ func axisBlock() schema.Block {
 						"This makes it easier to compare more than one graph’s worth of data because the axes are not shifted or stretched within visual proximity of each other.",
 				},*/
 			},
+			Validators: []validator.Object{
+				lessThanOrEqual("soft_min", "soft_max"),
+			},
 		},
 		Validators: []validator.List{
 			listvalidator.SizeAtMost(1),
@@ -345,23 +461,30 @@ func fieldBlock() schema.Block {
 								Description: "The colorization mode.",
 								MarkdownDescription: "The colorization mode. The most popular options:\n" +
 									"1) `fixed` - specific color set by using the value of `fixed_color`.\n" +
-									"2) `thresholds` - a color is derived from the matching threshold. This is useful for gauges, stat, and table visualizations.\n" +
-									"3) `palette-classic` - a color is derived from the matching threshold using the classic color palette.",
+									"2) `shades` - shades of the color set by using the value of `fixed_color`.\n" +
+									"3) `thresholds` - a color is derived from the matching threshold. This is useful for gauges, stat, and table visualizations.\n" +
+									"4) `palette-classic` - a color is derived from the matching threshold using the classic color palette.\n" +
+									"5) `continuous-*` - a color is derived from the value of the series, see `series_by`, using the continuous color scheme.",
 								Validators: []validator.String{
 									stringvalidator.OneOf(
-										"fixed", "thresholds", "palette-classic",
+										"fixed", "shades", "thresholds", "palette-classic", "palette-classic-by-name",
 										"continuous-GrYlRd", "continuous-RdYlGr", "continuous-BlYlRd", "continuous-YlRd", "continuous-BlPu", "continuous-YlBl",
 										"continuous-blues", "continuous-reds", "continuous-greens", "continuous-purples",
+										"continuous-viridis", "continuous-magma", "continuous-plasma", "continuous-inferno", "continuous-cividis",
 									),
 								},
 							},
 							"fixed_color": schema.StringAttribute{
 								Optional:    true,
-								Description: "The series to use to define the color. This is useful for graphs and pie charts, for example.",
+								Description: "The color to use in the fixed and shades modes. Either a named color of the Grafana palette, a hex or an rgb(a) color.",
+								Validators: []validator.String{
+									grafanaColor(),
+								},
 							},
 							"series_by": schema.StringAttribute{
-								Optional:    true,
-								Description: "The series to use to define the color. This is useful for graphs and pie charts, for example.",
+								Optional:            true,
+								Description:         "The value of the series that defines the color in the continuous color schemes, e.g. last, min, max.",
+								MarkdownDescription: "The value of the series that defines the color in the continuous color schemes, e.g. `last`, `min`, `max`.",
 							},
 						},
 						/* when fixed_color or series_by is present, mode must be present too
@@ -385,6 +508,9 @@ func fieldBlock() schema.Block {
 										"color": schema.StringAttribute{
 											Required:    true,
 											Description: "The color for the matching values.",
+											Validators: []validator.String{
+												grafanaColor(),
+											},
 										},
 										"value": schema.Float64Attribute{
 											Optional:    true,
@@ -396,6 +522,7 @@ func fieldBlock() schema.Block {
 								},
 								Validators: []validator.List{
 									listvalidator.SizeAtMost(20),
+									thresholdSteps(),
 								},
 							},
 						},
@@ -405,7 +532,7 @@ func fieldBlock() schema.Block {
 								Description: "The threshold mode. The choices are: absolute, percentage.",
 								MarkdownDescription: "The threshold mode. The choices are:\n" +
 									"1) `absolute` - defined based on a number; for example, 80 on a scale of 1 to 150. \n" +
-									"2) `percentage` - defined relative to minimum or maximum; for example, 80 percent.",
+									"2) `percentage` - defined relative to minimum or maximum; for example, 80 percent. The step values must be between `0` and `100`.",
 								Validators: []validator.String{
 									stringvalidator.OneOf("absolute", "percentage"),
 								},
@@ -417,11 +544,19 @@ func fieldBlock() schema.Block {
 					},
 				},
 				"mappings": mappingsBlock(),
+				"link":     dataLinkBlock(),
 			},
 			Attributes: map[string]schema.Attribute{
 				"unit": schema.StringAttribute{
 					Optional:    true,
 					Description: "The unit the field should use.",
+					MarkdownDescription: "The unit the field should use. " +
+						"Either the id of a [Grafana unit](https://github.com/grafana/grafana/blob/main/packages/grafana-data/src/valueFormats/categories.ts), e.g. `bytes`, `percent`, `reqps`, " +
+						"or a custom unit: `suffix:<suffix>`, `prefix:<prefix>`, `si:<base unit>`, `count:<unit>`, `time:<format>`, `currency:<symbol>`. " +
+						"The surrounding whitespace is trimmed and the case of a Grafana unit is normalized, e.g. `MS` becomes `ms`.",
+					Validators: []validator.String{
+						unit(),
+					},
 				},
 				"decimals": schema.Int64Attribute{
 					Optional:            true,
@@ -443,6 +578,27 @@ func fieldBlock() schema.Block {
 					Optional:    true,
 					Description: "The value to display if the field value is empty or null.",
 				},
+				"display_name": schema.StringAttribute{
+					Optional:    true,
+					Description: "The custom name of the field. Supports the template variables, e.g. ${__field.labels.instance}.",
+					MarkdownDescription: "The custom name of the field. Supports the template variables, e.g. `${__field.labels.instance}`. " +
+						"Note that `$` must be escaped as `$$` in Terraform strings.",
+				},
+				"description": schema.StringAttribute{
+					Optional:    true,
+					Description: "The description of the field, shown as a tooltip in the tables.",
+				},
+				"filterable": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether the ad hoc filters can be applied from the field values or not.",
+				},
+				"field_min_max": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to calculate min and max per field instead of all fields when they are not set explicitly.",
+				},
+			},
+			Validators: []validator.Object{
+				lessThan("min", "max"),
 			},
 		},
 		Validators: []validator.List{
@@ -477,11 +633,7 @@ func reduceOptionsBlock() schema.Block {
 						"`lastNotNull`, `last`, `firstNotNull`, `first`, `min`, `max`, `mean`, `sum`, `count`, `range`, " +
 						"`delta`, `step`, `diff`, `logmin`, `allIsZero`, `allIsNull`, `changeCount`, `distinctCount`, `diffperc`, `allValues`, `uniqueValues`",
 					Validators: []validator.String{
-						stringvalidator.OneOf(
-							"lastNotNull", "last", "firstNotNull", "first", "min", "max", "mean", "sum", // total
-							"count", "range", "delta", "step", "diff", "logmin", // min above zero
-							"allIsZero", "allIsNull", "changeCount", "distinctCount", "diffperc", "allValues", "uniqueValues",
-						),
+						stringvalidator.OneOf(calculations...),
 					},
 				},
 			},
@@ -537,8 +689,9 @@ func queryBlock() schema.Block {
 					NestedObject: schema.NestedBlockObject{
 						Attributes: map[string]schema.Attribute{
 							"uid": schema.StringAttribute{
-								Description: "The UID of a Prometheus DataSource to use in this query.",
-								Required:    true,
+								Description:         "The UID of a Prometheus DataSource to use in this query. A datasource variable can be referenced as $var or $${var}.",
+								MarkdownDescription: "The UID of a Prometheus DataSource to use in this query. A datasource variable can be referenced as `$var` or `$${var}`.",
+								Required:            true,
 							},
 							"expr": schema.StringAttribute{
 								Required:    true,
@@ -548,9 +701,19 @@ func queryBlock() schema.Block {
 								Optional:    true,
 								Description: "Whether to return the latest value from the time series or not.",
 							},
+							"range": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to return the values over the time range or not. Can be combined with instant.",
+							},
+							"exemplar": prometheusExemplarAttribute(),
+							"hide": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to exclude the query from the visualization or not.",
+							},
+							"editor_mode": prometheusEditorModeAttribute(),
 							"ref_id": schema.StringAttribute{
 								Optional:    true,
-								Description: "The ID of the query. The ID can be used to reference queries in math expressions.",
+								Description: "The ID of the query. The ID can be used to reference queries in math expressions and overrides. Defaults to the first unused letter: A, B, C, etc.",
 							},
 							"format": schema.StringAttribute{
 								Optional:            true,
@@ -564,9 +727,27 @@ func queryBlock() schema.Block {
 								Optional:    true,
 								Description: "The lower bounds on the interval between data points.",
 							},
+							"interval_factor": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The resolution of the query. For example, 2 means one data point for every two pixels.",
+								Validators: []validator.Int64{
+									int64validator.Between(1, 10),
+								},
+							},
+							"legend_mode": schema.StringAttribute{
+								Optional:            true,
+								Description:         "The legend mode. The choices are: auto, verbose, custom. The legend_format is used with the custom mode only.",
+								MarkdownDescription: "The legend mode. The choices are: `auto`, `verbose`, `custom`. The `legend_format` is used with the `custom` mode only.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("auto", "verbose", "custom"),
+								},
+							},
 							"legend_format": schema.StringAttribute{
 								Optional:    true,
-								Description: "The legend name.",
+								Description: "The legend name. Can be used with the custom legend_mode only.",
+								Validators: []validator.String{
+									legendFormat(),
+								},
 							},
 						},
 					},
@@ -599,8 +780,9 @@ func queryBlock() schema.Block {
 						},
 						Attributes: map[string]schema.Attribute{
 							"uid": schema.StringAttribute{
-								Description: "The UID of a CloudWatch DataSource to use in this query.",
-								Required:    true,
+								Description:         "The UID of a CloudWatch DataSource to use in this query. A datasource variable can be referenced as $var or $${var}.",
+								MarkdownDescription: "The UID of a CloudWatch DataSource to use in this query. A datasource variable can be referenced as `$var` or `$${var}`.",
+								Required:            true,
 							},
 							"namespace": schema.StringAttribute{
 								Required:    true,
@@ -626,7 +808,7 @@ func queryBlock() schema.Block {
 							},
 							"ref_id": schema.StringAttribute{
 								Optional:    true,
-								Description: "The ID of the query. The ID can be used to reference queries in math expressions.",
+								Description: "The ID of the query. The ID can be used to reference queries in math expressions and overrides. Defaults to the first unused letter: A, B, C, etc.",
 							},
 							"period": schema.StringAttribute{
 								Optional:    true,
@@ -642,6 +824,45 @@ func queryBlock() schema.Block {
 						listvalidator.SizeAtMost(5),
 					},
 				},
+				"raw": schema.ListNestedBlock{
+					Description: "The query of any data source. Can be used with data sources that have no dedicated block.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"datasource_uid": schema.StringAttribute{
+								Required:            true,
+								Description:         "The UID of a DataSource to use in this query. A datasource variable can be referenced as $var or $${var}.",
+								MarkdownDescription: "The UID of a DataSource to use in this query. A datasource variable can be referenced as `$var` or `$${var}`.",
+							},
+							"datasource_type": schema.StringAttribute{
+								Required:            true,
+								Description:         "The type of the DataSource plugin, e.g. loki or grafana-athena-datasource.",
+								MarkdownDescription: "The type of the DataSource plugin, e.g. `loki` or `grafana-athena-datasource`.",
+							},
+							"ref_id": schema.StringAttribute{
+								Optional:    true,
+								Description: "The ID of the query. The ID can be used to reference queries in math expressions and overrides. Defaults to the first unused letter: A, B, C, etc.",
+							},
+							"hide": schema.BoolAttribute{
+								Optional:    true,
+								Description: "Whether to exclude the query from the visualization or not.",
+							},
+							"model": schema.StringAttribute{
+								Optional: true,
+								Description: "The JSON-encoded query model of the DataSource plugin. " +
+									"The keys are merged into the query. The keys set by the attributes above (datasource, refId, hide) are not allowed.",
+								MarkdownDescription: "The JSON-encoded query model of the DataSource plugin. " +
+									"The keys are merged into the query. The keys set by the attributes above (`datasource`, `refId`, `hide`) are not allowed. " +
+									"Example: `jsonencode({ queryType = \"range\", maxLines = 1000 })`",
+								Validators: []validator.String{
+									jsonObject("datasource", "refId", "hide"),
+								},
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(5),
+					},
+				},
 			},
 		},
 		Validators: []validator.List{
@@ -650,6 +871,193 @@ func queryBlock() schema.Block {
 	}
 }
 
+func queryDefaultsBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "Query defaults.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"prometheus": schema.ListNestedBlock{
+					Description: "Prometheus query defaults.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"exemplar":    prometheusExemplarAttribute(),
+							"editor_mode": prometheusEditorModeAttribute(),
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func prometheusExemplarAttribute() schema.Attribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		Description: "Whether to fetch the exemplars along with the time series or not.",
+	}
+}
+
+func prometheusEditorModeAttribute() schema.Attribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		Description:         "The query editor mode to open the query with. The choices are: code, builder.",
+		MarkdownDescription: "The query editor mode to open the query with. The choices are: `code`, `builder`.",
+		Validators: []validator.String{
+			stringvalidator.OneOf("code", "builder"),
+		},
+	}
+}
+
+func queryOptionsBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The options that apply to all queries of the panel.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"max_data_points": schema.Int64Attribute{
+					Optional:    true,
+					Description: "The maximum number of data points the queries should return.",
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				"min_interval": schema.StringAttribute{
+					Optional:            true,
+					Description:         "The lower limit for the interval between data points, e.g. 1m.",
+					MarkdownDescription: "The lower limit for the interval between data points, e.g. `1m`.",
+				},
+				"relative_time": schema.StringAttribute{
+					Optional:            true,
+					Description:         "Overrides the relative time range of the dashboard for this panel, e.g. now-5m or 5m.",
+					MarkdownDescription: "Overrides the relative time range of the dashboard for this panel, e.g. `now-5m` or `5m`.",
+				},
+				"time_shift": schema.StringAttribute{
+					Optional:            true,
+					Description:         "Shifts the time range of the panel relative to the dashboard time picker, e.g. 1h or 1d.",
+					MarkdownDescription: "Shifts the time range of the panel relative to the dashboard time picker, e.g. `1h` or `1d`.",
+				},
+				"hide_time_info": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to hide the time override info in the panel header or not.",
+				},
+				"cache_timeout": schema.StringAttribute{
+					Optional:    true,
+					Description: "The query caching timeout in seconds. Applies only to data sources that support caching.",
+				},
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func panelLinksBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The links to show in the panel header, e.g. a runbook or the source code.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"title": schema.StringAttribute{
+					Required:    true,
+					Description: "The title of the link.",
+				},
+				"url": schema.StringAttribute{
+					Required:    true,
+					Description: "The URL to open.",
+					Validators: []validator.String{
+						urlReference(),
+					},
+				},
+				"target_blank": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to open the link in a new tab or not.",
+				},
+				"include_time_range": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to add the time range of the dashboard to the URL or not.",
+				},
+				"include_variables": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to add the variables of the dashboard to the URL or not.",
+				},
+			},
+		},
+	}
+}
+
+func panelDefaultsBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "Defaults of all panels. The links are appended to the links of every panel.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"links": panelLinksBlock(),
+			},
+		},
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+	}
+}
+
+func dataLinkBlock() schema.Block {
+	return schema.ListNestedBlock{
+		Description: "The links to show when a value of the field is clicked.",
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"internal": schema.ListNestedBlock{
+					Description: "The query to run in Explore instead of opening the URL.",
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"datasource_uid": schema.StringAttribute{
+								Required:    true,
+								Description: "The UID of a DataSource to run the query with.",
+							},
+							"datasource_name": schema.StringAttribute{
+								Optional:    true,
+								Description: "The name of the DataSource.",
+							},
+							"query": schema.StringAttribute{
+								Required:    true,
+								Description: "The JSON-encoded query model of the DataSource plugin.",
+								MarkdownDescription: "The JSON-encoded query model of the DataSource plugin. " +
+									"Example: `jsonencode({ query = \"$${__value.raw}\", queryType = \"traceql\" })`",
+								Validators: []validator.String{
+									jsonObject(),
+								},
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+				},
+			},
+			Attributes: map[string]schema.Attribute{
+				"title": schema.StringAttribute{
+					Required:    true,
+					Description: "The title of the link.",
+				},
+				"url": schema.StringAttribute{
+					Optional: true,
+					Description: "The URL to open. Supports variables like ${__value.raw} or ${__field.labels.name}. " +
+						"The variables must be escaped in Terraform strings: $${__value.raw}.",
+					MarkdownDescription: "The URL to open. Supports variables like `${__value.raw}` or `${__field.labels.name}`. " +
+						"The variables must be escaped in Terraform strings: `$${__value.raw}`.",
+				},
+				"target_blank": schema.BoolAttribute{
+					Optional:    true,
+					Description: "Whether to open the link in a new tab or not.",
+				},
+			},
+		},
+	}
+}
+
 func mappingsBlock() schema.Block {
 	return schema.ListNestedBlock{
 		Description: "The set of rules that translate a field value or range of values into explicit text.",
@@ -670,6 +1078,9 @@ func mappingsBlock() schema.Block {
 							"color": schema.StringAttribute{
 								Optional:    true,
 								Description: "The color to use if the condition is met.",
+								Validators: []validator.String{
+									grafanaColor(),
+								},
 							},
 						},
 					},
@@ -696,8 +1107,14 @@ func mappingsBlock() schema.Block {
 							"color": schema.StringAttribute{
 								Optional:    true,
 								Description: "The color to use if the condition is met.",
+								Validators: []validator.String{
+									grafanaColor(),
+								},
 							},
 						},
+						Validators: []validator.Object{
+							lessThanOrEqual("from", "to"),
+						},
 					},
 					Validators: []validator.List{
 						listvalidator.SizeAtMost(10),
@@ -718,6 +1135,9 @@ func mappingsBlock() schema.Block {
 							"color": schema.StringAttribute{
 								Optional:    true,
 								Description: "The color to use if the condition is met.",
+								Validators: []validator.String{
+									grafanaColor(),
+								},
 							},
 						},
 					},
@@ -744,6 +1164,9 @@ func mappingsBlock() schema.Block {
 							"color": schema.StringAttribute{
 								Optional:    true,
 								Description: "The color to use if the condition is met.",
+								Validators: []validator.String{
+									grafanaColor(),
+								},
 							},
 						},
 					},
@@ -759,7 +1182,14 @@ func mappingsBlock() schema.Block {
 	}
 }
 
-func fieldOverrideBlock() schema.Block {
+// calculations are the reducer functions supported by Grafana
+var calculations = []string{
+	"lastNotNull", "last", "firstNotNull", "first", "min", "max", "mean", "sum", // total
+	"count", "range", "delta", "step", "diff", "logmin", // min above zero
+	"allIsZero", "allIsNull", "changeCount", "distinctCount", "diffperc", "allValues", "uniqueValues",
+}
+
+func fieldOverrideBlock(field schema.Block) schema.Block {
 	return schema.ListNestedBlock{
 		Description: "The set of rules that override attributes of a field.",
 		NestedObject: schema.NestedBlockObject{
@@ -768,12 +1198,14 @@ func fieldOverrideBlock() schema.Block {
 					Description: "Override properties for a field with a specific name.",
 					NestedObject: schema.NestedBlockObject{
 						Blocks: map[string]schema.Block{
-							"field": fieldBlock(),
+							"field": field,
 						},
 						Attributes: map[string]schema.Attribute{
 							"name": schema.StringAttribute{
 								Required:    true,
-								Description: "The name of the field to override attributes for.",
+								Description: "The name of the field to override attributes for. The name is matched against the display name of the field, i.e. after the renames of the display_name property or the transformations.",
+								MarkdownDescription: "The name of the field to override attributes for. " +
+									"The name is matched against the display name of the field, i.e. after the renames of the `display_name` property or the transformations.",
 							},
 						},
 					},
@@ -785,7 +1217,7 @@ func fieldOverrideBlock() schema.Block {
 					Description: "Override properties for a field with a matching name.",
 					NestedObject: schema.NestedBlockObject{
 						Blocks: map[string]schema.Block{
-							"field": fieldBlock(),
+							"field": field,
 						},
 						Attributes: map[string]schema.Attribute{
 							"regex": schema.StringAttribute{
@@ -802,7 +1234,7 @@ func fieldOverrideBlock() schema.Block {
 					Description: "Override properties for a field with a specific type.",
 					NestedObject: schema.NestedBlockObject{
 						Blocks: map[string]schema.Block{
-							"field": fieldBlock(),
+							"field": field,
 						},
 						Attributes: map[string]schema.Attribute{
 							"type": schema.StringAttribute{
@@ -819,7 +1251,7 @@ func fieldOverrideBlock() schema.Block {
 					Description: "Override properties for a field returned by a specific query.",
 					NestedObject: schema.NestedBlockObject{
 						Blocks: map[string]schema.Block{
-							"field": fieldBlock(),
+							"field": field,
 						},
 						Attributes: map[string]schema.Attribute{
 							"query_id": schema.StringAttribute{
@@ -832,6 +1264,68 @@ func fieldOverrideBlock() schema.Block {
 						listvalidator.SizeAtMost(10),
 					},
 				},
+				"by_names": schema.ListNestedBlock{
+					Description: "Override properties for the fields with the listed names.",
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"field": field,
+						},
+						Attributes: map[string]schema.Attribute{
+							"names": schema.ListAttribute{
+								ElementType: types.StringType,
+								Required:    true,
+								Description: "The display names of the fields to override attributes for.",
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
+								},
+							},
+							"mode": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Whether to override the listed fields or all the other fields. The choices are: include, exclude. Defaults to include.",
+								MarkdownDescription: "Whether to override the listed fields or all the other fields. The choices are: `include`, `exclude`. Defaults to `include`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("include", "exclude"),
+								},
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(10),
+					},
+				},
+				"by_value": schema.ListNestedBlock{
+					Description: "Override properties for the fields whose reduced value matches the condition.",
+					NestedObject: schema.NestedBlockObject{
+						Blocks: map[string]schema.Block{
+							"field": field,
+						},
+						Attributes: map[string]schema.Attribute{
+							"reducer": schema.StringAttribute{
+								Required:            true,
+								Description:         "The calculation that reduces the values of a field to a single value. The choices are: " + strings.Join(calculations, ", ") + ".",
+								MarkdownDescription: "The calculation that reduces the values of a field to a single value. The choices are: `" + strings.Join(calculations, "`, `") + "`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf(calculations...),
+								},
+							},
+							"operator": schema.StringAttribute{
+								Required:            true,
+								Description:         "The comparison of the reduced value with the value. The choices are: greater, greater_or_equal, lower, lower_or_equal, equal, not_equal.",
+								MarkdownDescription: "The comparison of the reduced value with the value. The choices are: `greater`, `greater_or_equal`, `lower`, `lower_or_equal`, `equal`, `not_equal`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf("greater", "greater_or_equal", "lower", "lower_or_equal", "equal", "not_equal"),
+								},
+							},
+							"value": schema.Float64Attribute{
+								Required:    true,
+								Description: "The value to compare the reduced value with.",
+							},
+						},
+					},
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(10),
+					},
+				},
 			},
 		},
 		Validators: []validator.List{
@@ -870,22 +1364,42 @@ func descriptionAttribute() schema.StringAttribute {
 
 // creators
 
-func createTargets(queries []Query) []grafana.Target {
+func createTargets(defaults QueryDefaults, queries []Query, diagnostics *diag.Diagnostics) []grafana.Target {
 	targets := make([]grafana.Target, 0)
 
 	for _, group := range queries {
 		for _, target := range group.Prometheus {
 			t := grafana.Target{
 				Datasource: grafana.Datasource{
-					UID:  target.Uid.ValueString(),
+					UID:  datasourceUID(target.Uid.ValueString()),
 					Type: "prometheus",
 				},
-				RefID:        target.RefId.ValueString(),
-				Expr:         target.Expr.ValueString(),
-				Interval:     target.MinInterval.ValueString(),
-				LegendFormat: target.LegendFormat.ValueString(),
-				Instant:      target.Instant.ValueBool(),
-				Format:       target.Format.ValueString(),
+				RefID:          target.RefId.ValueString(),
+				Hide:           target.Hide.ValueBool(),
+				Expr:           target.Expr.ValueString(),
+				Interval:       target.MinInterval.ValueString(),
+				IntervalFactor: int(target.IntervalFactor.ValueInt64()),
+				LegendFormat:   target.LegendFormat.ValueString(),
+				Instant:        target.Instant.ValueBool(),
+				Range:          target.Range.ValueBool(),
+				Exemplar:       defaults.Prometheus.Exemplar,
+				EditorMode:     defaults.Prometheus.EditorMode,
+				Format:         target.Format.ValueString(),
+			}
+
+			if !target.Exemplar.IsNull() {
+				t.Exemplar = target.Exemplar.ValueBool()
+			}
+
+			if !target.EditorMode.IsNull() {
+				t.EditorMode = target.EditorMode.ValueString()
+			}
+
+			switch target.LegendMode.ValueString() {
+			case "auto":
+				t.LegendFormat = "__auto"
+			case "verbose":
+				t.LegendFormat = ""
 			}
 
 			targets = append(targets, t)
@@ -900,7 +1414,7 @@ func createTargets(queries []Query) []grafana.Target {
 
 			t := grafana.Target{
 				Datasource: grafana.Datasource{
-					UID:  target.Uid.ValueString(),
+					UID:  datasourceUID(target.Uid.ValueString()),
 					Type: "cloudwatch",
 				},
 				RefID:      target.RefId.ValueString(),
@@ -915,11 +1429,112 @@ func createTargets(queries []Query) []grafana.Target {
 
 			targets = append(targets, t)
 		}
+
+		for _, target := range group.Raw {
+			var model map[string]interface{}
+
+			if !target.Model.IsNull() {
+				if err := json.Unmarshal([]byte(target.Model.ValueString()), &model); err != nil {
+					diagnostics.AddError("Client Error", fmt.Sprintf("Could not unmarshall the query model: %s", err))
+					return nil
+				}
+			}
+
+			t := grafana.Target{
+				Datasource: grafana.Datasource{
+					UID:  datasourceUID(target.DatasourceUid.ValueString()),
+					Type: target.DatasourceType.ValueString(),
+				},
+				RefID: target.RefId.ValueString(),
+				Hide:  target.Hide.ValueBool(),
+				Model: model,
+			}
+
+			targets = append(targets, t)
+		}
 	}
 
+	assignRefIDs(targets)
+
 	return targets
 }
 
+// variableReferencePattern matches a reference to a template variable: $var, ${var} or [[var]].
+var variableReferencePattern = regexp.MustCompile(`^(?:\$(\w+)|\$\{(\w+)\}|\[\[(\w+)\]\])$`)
+
+// datasourceVariable returns the name of the template variable referenced by the data source UID, if any.
+func datasourceVariable(uid string) (string, bool) {
+	match := variableReferencePattern.FindStringSubmatch(uid)
+	if match == nil {
+		return "", false
+	}
+
+	for _, name := range match[1:] {
+		if name != "" {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+// datasourceUID normalizes a reference to a template variable to the ${var} syntax used by Grafana.
+func datasourceUID(uid string) string {
+	if name, ok := datasourceVariable(uid); ok {
+		return "${" + name + "}"
+	}
+
+	return uid
+}
+
+// assignRefIDs sets Grafana-style ref IDs (A, B, ..., Z, AA, AB, ...) to the targets without one.
+func assignRefIDs(targets []grafana.Target) {
+	refIDs := make([]string, len(targets))
+
+	for i, target := range targets {
+		refIDs[i] = target.RefID
+	}
+
+	fillRefIDs(refIDs)
+
+	for i := range targets {
+		targets[i].RefID = refIDs[i]
+	}
+}
+
+// fillRefIDs replaces the empty ref IDs with Grafana-style ones (A, B, ..., Z, AA, AB, ...).
+// The IDs are assigned in order, skipping the ones that are already in use.
+func fillRefIDs(refIDs []string) {
+	used := make(map[string]bool)
+
+	for _, id := range refIDs {
+		used[id] = true
+	}
+
+	next := 0
+
+	for i := range refIDs {
+		if refIDs[i] != "" {
+			continue
+		}
+
+		for used[refID(next)] {
+			next++
+		}
+
+		refIDs[i] = refID(next)
+		used[refIDs[i]] = true
+	}
+}
+
+func refID(n int) string {
+	if n < 26 {
+		return string(rune('A' + n))
+	}
+
+	return refID(n/26-1) + refID(n%26)
+}
+
 type ValueMappingResult struct {
 	Color string `json:"color,omitempty"`
 	Text  string `json:"text,omitempty"`
@@ -937,10 +1552,15 @@ func createFieldConfig(defaults FieldDefaults, fieldOptions []FieldOptions) graf
 	}
 
 	fieldConfig := grafana.FieldConfigDefaults{
-		Unit:     defaults.Unit,
-		Decimals: defaults.Decimals,
-		Min:      defaults.Min,
-		Max:      defaults.Max,
+		Unit:        defaults.Unit,
+		Decimals:    defaults.Decimals,
+		Min:         defaults.Min,
+		Max:         defaults.Max,
+		NoValue:     defaults.NoValue,
+		DisplayName: defaults.DisplayName,
+		Description: defaults.Description,
+		Filterable:  defaults.Filterable,
+		FieldMinMax: defaults.FieldMinMax,
 		Color: grafana.FieldConfigColor{
 			Mode:       defaults.Color.Mode,
 			FixedColor: defaults.Color.FixedColor,
@@ -950,11 +1570,13 @@ func createFieldConfig(defaults FieldDefaults, fieldOptions []FieldOptions) graf
 			Mode:  defaults.Thresholds.Mode,
 			Steps: thresholdStep,
 		},
+		Mappings: defaults.Mappings,
+		Links:    defaults.Links,
 	}
 
 	for _, field := range fieldOptions {
 		if !field.Unit.IsNull() {
-			fieldConfig.Unit = field.Unit.ValueString()
+			fieldConfig.Unit, _ = normalizeUnit(field.Unit.ValueString())
 		}
 
 		if !field.Decimals.IsNull() {
@@ -977,13 +1599,31 @@ func createFieldConfig(defaults FieldDefaults, fieldOptions []FieldOptions) graf
 			fieldConfig.NoValue = &noValue
 		}
 
+		if !field.DisplayName.IsNull() {
+			fieldConfig.DisplayName = field.DisplayName.ValueString()
+		}
+
+		if !field.Description.IsNull() {
+			fieldConfig.Description = field.Description.ValueString()
+		}
+
+		if !field.Filterable.IsNull() {
+			filterable := field.Filterable.ValueBool()
+			fieldConfig.Filterable = &filterable
+		}
+
+		if !field.FieldMinMax.IsNull() {
+			fieldMinMax := field.FieldMinMax.ValueBool()
+			fieldConfig.FieldMinMax = &fieldMinMax
+		}
+
 		for _, color := range field.Color {
 			if !color.Mode.IsNull() {
 				fieldConfig.Color.Mode = color.Mode.ValueString()
 			}
 
 			if !color.FixedColor.IsNull() {
-				fieldConfig.Color.FixedColor = color.FixedColor.ValueString()
+				fieldConfig.Color.FixedColor = normalizeColor(color.FixedColor.ValueString())
 			}
 
 			if !color.SeriesBy.IsNull() {
@@ -997,12 +1637,98 @@ func createFieldConfig(defaults FieldDefaults, fieldOptions []FieldOptions) graf
 			fieldConfig.Mappings = mappings
 		}
 
+		links := createDataLinks(field.Links)
+
+		if len(links) > 0 {
+			fieldConfig.Links = links
+		}
+
 		updateThresholds(&fieldConfig.Thresholds, field.Thresholds)
 	}
 
 	return fieldConfig
 }
 
+// createPanelLinks returns the links of the panel followed by the default links.
+func createPanelLinks(defaults PanelDefaults, linkOptions []PanelLinkOptions) []grafana.DataLink {
+	links := make([]grafana.DataLink, 0)
+
+	for _, link := range linkOptions {
+		params := make([]string, 0)
+
+		if link.IncludeTimeRange.ValueBool() {
+			params = append(params, "${__url_time_range}")
+		}
+
+		if link.IncludeVariables.ValueBool() {
+			params = append(params, "${__all_variables}")
+		}
+
+		links = append(links, grafana.DataLink{
+			Title:       link.Title.ValueString(),
+			URL:         appendQueryParams(link.Url.ValueString(), params),
+			TargetBlank: link.TargetBlank.ValueBool(),
+		})
+	}
+
+	return append(links, defaults.Links...)
+}
+
+// appendQueryParams appends the params to the query of the URL, the fragment stays at the end.
+// The URL is not re-encoded by url.URL.String, otherwise the variables like ${var} get escaped.
+func appendQueryParams(rawURL string, params []string) string {
+	if len(params) == 0 {
+		return rawURL
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	base, fragment, hasFragment := strings.Cut(rawURL, "#")
+	base, _, _ = strings.Cut(base, "?")
+
+	query := strings.Join(params, "&")
+	if u.RawQuery != "" {
+		query = u.RawQuery + "&" + query
+	}
+
+	result := base + "?" + query
+	if hasFragment {
+		result += "#" + fragment
+	}
+
+	return result
+}
+
+func createDataLinks(linkOptions []DataLinkOptions) []grafana.DataLink {
+	links := make([]grafana.DataLink, 0)
+
+	for _, link := range linkOptions {
+		l := grafana.DataLink{
+			Title:       link.Title.ValueString(),
+			URL:         link.Url.ValueString(),
+			TargetBlank: link.TargetBlank.ValueBool(),
+		}
+
+		for _, internal := range link.Internal {
+			query := make(map[string]interface{})
+			_ = json.Unmarshal([]byte(internal.Query.ValueString()), &query)
+
+			l.Internal = &grafana.DataLinkInternal{
+				DatasourceUID:  internal.DatasourceUid.ValueString(),
+				DatasourceName: internal.DatasourceName.ValueString(),
+				Query:          query,
+			}
+		}
+
+		links = append(links, l)
+	}
+
+	return links
+}
+
 func createMappings(mappingOptions []MappingOptions) []grafana.FieldMapping {
 	mappings := make([]grafana.FieldMapping, 0)
 
@@ -1012,7 +1738,7 @@ func createMappings(mappingOptions []MappingOptions) []grafana.FieldMapping {
 
 		for _, value := range mapping.Value {
 			v := ValueMappingResult{
-				Color: value.Color.ValueString(),
+				Color: normalizeColor(value.Color.ValueString()),
 				Text:  value.DisplayText.ValueString(),
 				Index: idx,
 			}
@@ -1035,9 +1761,9 @@ func createMappings(mappingOptions []MappingOptions) []grafana.FieldMapping {
 				Type: "range",
 				Options: map[string]interface{}{
 					"from": range_.From.ValueFloat64(),
-					"to":   range_.From.ValueFloat64(),
+					"to":   range_.To.ValueFloat64(),
 					"result": ValueMappingResult{
-						Color: range_.Color.ValueString(),
+						Color: normalizeColor(range_.Color.ValueString()),
 						Text:  range_.DisplayText.ValueString(),
 						Index: idx,
 					},
@@ -1054,7 +1780,7 @@ func createMappings(mappingOptions []MappingOptions) []grafana.FieldMapping {
 				Options: map[string]interface{}{
 					"pattern": regex.Pattern.ValueString(),
 					"result": ValueMappingResult{
-						Color: regex.Color.ValueString(),
+						Color: normalizeColor(regex.Color.ValueString()),
 						Text:  regex.DisplayText.ValueString(),
 						Index: idx,
 					},
@@ -1071,7 +1797,7 @@ func createMappings(mappingOptions []MappingOptions) []grafana.FieldMapping {
 				Options: map[string]interface{}{
 					"match": special.Match.ValueString(),
 					"result": ValueMappingResult{
-						Color: special.Color.ValueString(),
+						Color: normalizeColor(special.Color.ValueString()),
 						Text:  special.DisplayText.ValueString(),
 						Index: idx,
 					},
@@ -1086,7 +1812,7 @@ func createMappings(mappingOptions []MappingOptions) []grafana.FieldMapping {
 	return mappings
 }
 
-func createOverrides(overrides []FieldOverrideOptions) []grafana.FieldOverride {
+func createOverrides[F any](overrides []FieldOverrideOptions[F], createProperties func([]F) []grafana.FieldOverrideProperty) []grafana.FieldOverride {
 	fieldOverrides := make([]grafana.FieldOverride, 0)
 
 	for _, override := range overrides {
@@ -1096,7 +1822,7 @@ func createOverrides(overrides []FieldOverrideOptions) []grafana.FieldOverride {
 					Id:      "byName",
 					Options: byName.Name.ValueString(),
 				},
-				Properties: createOverrideProperties(byName.Field),
+				Properties: createProperties(byName.Field),
 			}
 			fieldOverrides = append(fieldOverrides, fieldOverride)
 		}
@@ -1107,7 +1833,7 @@ func createOverrides(overrides []FieldOverrideOptions) []grafana.FieldOverride {
 					Id:      "byRegexp",
 					Options: byRegex.Regex.ValueString(),
 				},
-				Properties: createOverrideProperties(byRegex.Field),
+				Properties: createProperties(byRegex.Field),
 			}
 			fieldOverrides = append(fieldOverrides, fieldOverride)
 		}
@@ -1118,7 +1844,7 @@ func createOverrides(overrides []FieldOverrideOptions) []grafana.FieldOverride {
 					Id:      "byType",
 					Options: byType.Type.ValueString(),
 				},
-				Properties: createOverrideProperties(byType.Field),
+				Properties: createProperties(byType.Field),
 			}
 			fieldOverrides = append(fieldOverrides, fieldOverride)
 		}
@@ -1129,7 +1855,50 @@ func createOverrides(overrides []FieldOverrideOptions) []grafana.FieldOverride {
 					Id:      "byFrameRefID",
 					Options: byQueryID.QueryID.ValueString(),
 				},
-				Properties: createOverrideProperties(byQueryID.Field),
+				Properties: createProperties(byQueryID.Field),
+			}
+			fieldOverrides = append(fieldOverrides, fieldOverride)
+		}
+
+		for _, byNames := range override.ByNames {
+			mode := "include"
+			if !byNames.Mode.IsNull() {
+				mode = byNames.Mode.ValueString()
+			}
+
+			fieldOverride := grafana.FieldOverride{
+				Matcher: grafana.FieldOverrideMatcher{
+					Id: "byNames",
+					Options: map[string]interface{}{
+						"mode":  mode,
+						"names": stringValues(byNames.Names),
+					},
+				},
+				Properties: createProperties(byNames.Field),
+			}
+			fieldOverrides = append(fieldOverrides, fieldOverride)
+		}
+
+		for _, byValue := range override.ByValue {
+			operators := map[string]string{
+				"greater":          "gt",
+				"greater_or_equal": "gte",
+				"lower":            "lt",
+				"lower_or_equal":   "lte",
+				"equal":            "eq",
+				"not_equal":        "neq",
+			}
+
+			fieldOverride := grafana.FieldOverride{
+				Matcher: grafana.FieldOverrideMatcher{
+					Id: "byValue",
+					Options: map[string]interface{}{
+						"reducer": byValue.Reducer.ValueString(),
+						"op":      operators[byValue.Operator.ValueString()],
+						"value":   byValue.Value.ValueFloat64(),
+					},
+				},
+				Properties: createProperties(byValue.Field),
 			}
 			fieldOverrides = append(fieldOverrides, fieldOverride)
 		}
@@ -1143,9 +1912,11 @@ func createOverrideProperties(fieldOptions []FieldOptions) []grafana.FieldOverri
 
 	for _, field := range fieldOptions {
 		if !field.Unit.IsNull() {
+			normalized, _ := normalizeUnit(field.Unit.ValueString())
+
 			properties = append(properties, grafana.FieldOverrideProperty{
 				Id:    "unit",
-				Value: field.Unit.ValueString(),
+				Value: normalized,
 			})
 		}
 
@@ -1173,7 +1944,35 @@ func createOverrideProperties(fieldOptions []FieldOptions) []grafana.FieldOverri
 		if !field.NoValue.IsNull() {
 			properties = append(properties, grafana.FieldOverrideProperty{
 				Id:    "noValue",
-				Value: field.Decimals.ValueInt64(),
+				Value: field.NoValue.ValueFloat64(),
+			})
+		}
+
+		if !field.DisplayName.IsNull() {
+			properties = append(properties, grafana.FieldOverrideProperty{
+				Id:    "displayName",
+				Value: field.DisplayName.ValueString(),
+			})
+		}
+
+		if !field.Description.IsNull() {
+			properties = append(properties, grafana.FieldOverrideProperty{
+				Id:    "description",
+				Value: field.Description.ValueString(),
+			})
+		}
+
+		if !field.Filterable.IsNull() {
+			properties = append(properties, grafana.FieldOverrideProperty{
+				Id:    "filterable",
+				Value: field.Filterable.ValueBool(),
+			})
+		}
+
+		if !field.FieldMinMax.IsNull() {
+			properties = append(properties, grafana.FieldOverrideProperty{
+				Id:    "fieldMinMax",
+				Value: field.FieldMinMax.ValueBool(),
 			})
 		}
 
@@ -1184,7 +1983,7 @@ func createOverrideProperties(fieldOptions []FieldOptions) []grafana.FieldOverri
 			}
 
 			if !color.FixedColor.IsNull() {
-				fieldColor.FixedColor = color.FixedColor.ValueString()
+				fieldColor.FixedColor = normalizeColor(color.FixedColor.ValueString())
 			}
 
 			if !color.SeriesBy.IsNull() {
@@ -1206,6 +2005,15 @@ func createOverrideProperties(fieldOptions []FieldOptions) []grafana.FieldOverri
 			})
 		}
 
+		links := createDataLinks(field.Links)
+
+		if len(links) > 0 {
+			properties = append(properties, grafana.FieldOverrideProperty{
+				Id:    "links",
+				Value: links,
+			})
+		}
+
 		thresholds := grafana.Thresholds{}
 		updateThresholds(&thresholds, field.Thresholds)
 
@@ -1221,6 +2029,67 @@ func createOverrideProperties(fieldOptions []FieldOptions) []grafana.FieldOverri
 }
 
 // updaters
+func updateQueryOptions(panel *grafana.CommonPanel, defaults QueryOptionsDefaults, opts []QueryOptions) {
+	panel.MaxDataPoints = defaults.MaxDataPoints
+
+	if defaults.MinInterval != "" {
+		interval := defaults.MinInterval
+		panel.Interval = &interval
+	}
+
+	if defaults.RelativeTime != "" {
+		timeFrom := defaults.RelativeTime
+		panel.TimeFrom = &timeFrom
+	}
+
+	if defaults.TimeShift != "" {
+		timeShift := defaults.TimeShift
+		panel.TimeShift = &timeShift
+	}
+
+	if defaults.HideTimeInfo {
+		hide := defaults.HideTimeInfo
+		panel.HideTimeOverride = &hide
+	}
+
+	if defaults.CacheTimeout != "" {
+		cacheTimeout := defaults.CacheTimeout
+		panel.CacheTimeout = &cacheTimeout
+	}
+
+	for _, options := range opts {
+		if !options.MaxDataPoints.IsNull() {
+			maxDataPoints := int(options.MaxDataPoints.ValueInt64())
+			panel.MaxDataPoints = &maxDataPoints
+		}
+
+		if !options.MinInterval.IsNull() {
+			interval := options.MinInterval.ValueString()
+			panel.Interval = &interval
+		}
+
+		if !options.RelativeTime.IsNull() {
+			timeFrom := options.RelativeTime.ValueString()
+			panel.TimeFrom = &timeFrom
+		}
+
+		if !options.TimeShift.IsNull() {
+			timeShift := options.TimeShift.ValueString()
+			panel.TimeShift = &timeShift
+		}
+
+		if !options.HideTimeInfo.IsNull() {
+			hide := options.HideTimeInfo.ValueBool()
+			panel.HideTimeOverride = &hide
+		}
+
+		if !options.CacheTimeout.IsNull() {
+			cacheTimeout := options.CacheTimeout.ValueString()
+			panel.CacheTimeout = &cacheTimeout
+		}
+	}
+}
+
 func updateThresholds(thresholds *grafana.Thresholds, thresholdOptions []ThresholdOptions) {
 	for _, threshold := range thresholdOptions {
 		steps := make([]grafana.ThresholdStep, len(threshold.Steps))
@@ -1231,7 +2100,7 @@ func updateThresholds(thresholds *grafana.Thresholds, thresholdOptions []Thresho
 
 		for i, step := range threshold.Steps {
 			s := grafana.ThresholdStep{
-				Color: step.Color.ValueString(),
+				Color: normalizeColor(step.Color.ValueString()),
 			}
 
 			if !step.Value.IsNull() {
@@ -0,0 +1,768 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/iRevive/terraform-provider-gdashboard/internal/provider/grafana"
+)
+
+var _ validator.String = jsonObjectValidator{}
+
+// jsonObjectValidator validates that the value is a JSON-encoded object without the reserved keys.
+type jsonObjectValidator struct {
+	reserved []string
+}
+
+func (v jsonObjectValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v jsonObjectValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a JSON-encoded object"
+}
+
+func (v jsonObjectValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var object map[string]interface{}
+
+	if err := json.Unmarshal([]byte(request.ConfigValue.ValueString()), &object); err != nil || object == nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s", request.Path, v.Description(ctx), request.ConfigValue.ValueString()),
+		)
+		return
+	}
+
+	for _, key := range v.reserved {
+		if _, ok := object[key]; ok {
+			response.Diagnostics.AddAttributeError(
+				request.Path,
+				"Invalid Attribute Value",
+				fmt.Sprintf("Attribute %s must not define the key %q, it is set by the other attributes.", request.Path, key),
+			)
+		}
+	}
+}
+
+// jsonObject checks that the String held in the attribute is a JSON-encoded object.
+// The reserved keys are owned by the typed attributes and cannot be defined in the object.
+func jsonObject(reserved ...string) validator.String {
+	return jsonObjectValidator{reserved: reserved}
+}
+
+var _ validator.String = urlReferenceValidator{}
+
+// urlReferenceValidator validates that the value is a URL or a URL reference, e.g. /d/uid?orgId=1#panel.
+type urlReferenceValidator struct{}
+
+func (v urlReferenceValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v urlReferenceValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a valid URL"
+}
+
+func (v urlReferenceValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := url.Parse(request.ConfigValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got: %s", request.Path, v.Description(ctx), err),
+		)
+	}
+}
+
+// urlReference checks that the String held in the attribute can be parsed as a URL.
+func urlReference() validator.String {
+	return urlReferenceValidator{}
+}
+
+var _ validator.String = legendFormatValidator{}
+
+// legendFormatValidator validates that the legend format is defined along with the custom legend mode only.
+type legendFormatValidator struct{}
+
+func (v legendFormatValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v legendFormatValidator) MarkdownDescription(_ context.Context) string {
+	return "legend_format can be used with the custom legend_mode only"
+}
+
+func (v legendFormatValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var mode types.String
+
+	diags := request.Config.GetAttribute(ctx, request.Path.ParentPath().AtName("legend_mode"), &mode)
+	if diags.HasError() || mode.IsNull() || mode.IsUnknown() || mode.ValueString() == "custom" {
+		return
+	}
+
+	response.Diagnostics.AddAttributeError(
+		request.Path,
+		"Invalid Attribute Combination",
+		fmt.Sprintf("Attribute %s, got: legend_mode = %s", v.Description(ctx), mode.ValueString()),
+	)
+}
+
+// legendFormat checks that the legend format does not conflict with the auto and verbose legend modes.
+func legendFormat() validator.String {
+	return legendFormatValidator{}
+}
+
+var _ validator.String = unitValidator{}
+
+// unitValidator validates that the value is a unit known to Grafana or a custom unit.
+type unitValidator struct{}
+
+func (v unitValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v unitValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value must be a Grafana unit or a custom unit in one of the forms: %s<value>", strings.Join(customUnitPrefixes, "<value>, "))
+}
+
+func (v unitValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if isKnownUnit(value) {
+		return
+	}
+
+	details := fmt.Sprintf("Attribute %s %s, got: %q", request.Path, v.Description(ctx), value)
+
+	if similar := closestMatches(value, units); len(similar) > 0 {
+		details += fmt.Sprintf(". Did you mean %q?", strings.Join(similar, `" or "`))
+	}
+
+	response.Diagnostics.AddAttributeError(request.Path, "Invalid Attribute Value", details)
+}
+
+// unit checks that the String held in the attribute is a unit supported by Grafana.
+func unit() validator.String {
+	return unitValidator{}
+}
+
+var _ validator.String = colorValidator{}
+
+var (
+	hexColorRegex = regexp.MustCompile(`^#([0-9a-fA-F]{3,4}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+	rgbColorRegex = regexp.MustCompile(`^rgba?\(\s*\d{1,3}\s*,\s*\d{1,3}\s*,\s*\d{1,3}\s*(,\s*(0|1|0?\.\d+)\s*)?\)$`)
+)
+
+// colorValidator validates that the value is a named color of the Grafana palette, a hex or an rgb(a) color.
+type colorValidator struct{}
+
+func (v colorValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v colorValidator) MarkdownDescription(_ context.Context) string {
+	return "value must be a named color of the Grafana palette (e.g. semi-dark-red), a hex color (e.g. #73bf69) or an rgb(a) color (e.g. rgba(115, 191, 105, 0.5))"
+}
+
+func (v colorValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+
+	if isNamedColor(value) || hexColorRegex.MatchString(value) || rgbColorRegex.MatchString(value) {
+		return
+	}
+
+	details := fmt.Sprintf("Attribute %s %s, got: %q", request.Path, v.Description(ctx), value)
+
+	if similar := closestMatches(value, namedColors()); len(similar) > 0 {
+		details += fmt.Sprintf(". Did you mean %q?", strings.Join(similar, `" or "`))
+	}
+
+	response.Diagnostics.AddAttributeError(request.Path, "Invalid Attribute Value", details)
+}
+
+// grafanaColor checks that the String held in the attribute is a color supported by Grafana.
+func grafanaColor() validator.String {
+	return colorValidator{}
+}
+
+var _ validator.List = thresholdStepsValidator{}
+
+// thresholdStepsValidator validates that the threshold steps start with the base step and continue in ascending order.
+type thresholdStepsValidator struct{}
+
+func (v thresholdStepsValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v thresholdStepsValidator) MarkdownDescription(_ context.Context) string {
+	return "the first step must be the base step without a value, the values of the following steps must be set and ascending, " +
+		"between 0 and 100 in the percentage mode"
+}
+
+func (v thresholdStepsValidator) ValidateList(ctx context.Context, request validator.ListRequest, response *validator.ListResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var mode types.String
+
+	diags := request.Config.GetAttribute(ctx, request.Path.ParentPath().AtName("mode"), &mode)
+	percentage := !diags.HasError() && mode.ValueString() == "percentage"
+
+	var previous *float64
+
+	for i, element := range request.ConfigValue.Elements() {
+		step, ok := element.(types.Object)
+		if !ok || step.IsUnknown() {
+			return
+		}
+
+		value, ok := step.Attributes()["value"].(types.Float64)
+		if !ok || value.IsUnknown() {
+			return
+		}
+
+		valuePath := request.Path.AtListIndex(i).AtName("value")
+
+		if i == 0 {
+			if !value.IsNull() {
+				response.Diagnostics.AddAttributeError(
+					valuePath,
+					"Invalid Threshold Step",
+					fmt.Sprintf("The first step defines the base color and must not have a value, got: %v. Add a step without a value before this one.", value.ValueFloat64()),
+				)
+			}
+			continue
+		}
+
+		if value.IsNull() {
+			response.Diagnostics.AddAttributeError(
+				valuePath,
+				"Invalid Threshold Step",
+				"Only the first step can omit the value. Set the value of this step.",
+			)
+			continue
+		}
+
+		current := value.ValueFloat64()
+
+		if percentage && (current < 0 || current > 100) {
+			response.Diagnostics.AddAttributeError(
+				valuePath,
+				"Invalid Threshold Step",
+				fmt.Sprintf("The value of the percentage threshold step must be between 0 and 100, got: %v.", current),
+			)
+		}
+
+		if previous != nil && current <= *previous {
+			response.Diagnostics.AddAttributeError(
+				valuePath,
+				"Invalid Threshold Step",
+				fmt.Sprintf("The threshold steps must be in ascending order, got: %v after %v.", current, *previous),
+			)
+		}
+
+		previous = &current
+	}
+}
+
+// thresholdSteps checks that the threshold steps start with the base step and continue in ascending order.
+// The values of the steps must be between 0 and 100 in the percentage mode.
+func thresholdSteps() validator.List {
+	return thresholdStepsValidator{}
+}
+
+var _ validator.List = multiSelectionValidator{}
+
+// multiSelectionValidator validates that a variable selects multiple values only when multi is enabled.
+type multiSelectionValidator struct{}
+
+func (v multiSelectionValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v multiSelectionValidator) MarkdownDescription(_ context.Context) string {
+	return "multiple selected values require multi to be enabled"
+}
+
+func (v multiSelectionValidator) ValidateList(ctx context.Context, request validator.ListRequest, response *validator.ListResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	selected := 0
+
+	for _, element := range request.ConfigValue.Elements() {
+		switch value := element.(type) {
+		case types.Object:
+			// the options are selected explicitly
+			if flag, ok := value.Attributes()["selected"].(types.Bool); ok && flag.ValueBool() {
+				selected++
+			}
+		default:
+			selected++
+		}
+	}
+
+	if selected < 2 {
+		return
+	}
+
+	var multi types.Bool
+
+	diags := request.Config.GetAttribute(ctx, request.Path.ParentPath().AtName("multi"), &multi)
+	if diags.HasError() || multi.IsUnknown() || multi.ValueBool() {
+		return
+	}
+
+	response.Diagnostics.AddAttributeError(
+		request.Path,
+		"Invalid Attribute Combination",
+		fmt.Sprintf("Attribute %s %s, got: %d selected values", request.Path, v.Description(ctx), selected),
+	)
+}
+
+// multiSelection checks that multiple values, or options marked as selected, are defined only when multi is enabled.
+func multiSelection() validator.List {
+	return multiSelectionValidator{}
+}
+
+var _ validator.Object = intervalCurrentValidator{}
+
+// intervalCurrentValidator validates that the current value of an interval variable is one of its options.
+type intervalCurrentValidator struct{}
+
+func (v intervalCurrentValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v intervalCurrentValidator) MarkdownDescription(_ context.Context) string {
+	return "must be one of the intervals or auto when auto is enabled"
+}
+
+func (v intervalCurrentValidator) ValidateObject(ctx context.Context, request validator.ObjectRequest, response *validator.ObjectResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := request.ConfigValue.Attributes()
+
+	current, ok := attributes["current"].(types.String)
+	if !ok || current.IsNull() || current.IsUnknown() {
+		return
+	}
+
+	auto, ok := attributes["auto"].(types.Bool)
+	if !ok || auto.IsUnknown() {
+		return
+	}
+
+	intervals, ok := attributes["intervals"].(types.List)
+	if !ok || intervals.IsUnknown() {
+		return
+	}
+
+	value := current.ValueString()
+
+	if value == "auto" && auto.ValueBool() {
+		return
+	}
+
+	for _, element := range intervals.Elements() {
+		interval, ok := element.(types.String)
+		if !ok || interval.IsUnknown() || interval.ValueString() == value {
+			return
+		}
+	}
+
+	currentPath := request.Path.AtName("current")
+
+	response.Diagnostics.AddAttributeError(
+		currentPath,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s %s, got: %q", currentPath, v.Description(ctx), value),
+	)
+}
+
+// intervalCurrent checks that the current value of an interval variable is one of the intervals, or auto when auto is enabled.
+func intervalCurrent() validator.Object {
+	return intervalCurrentValidator{}
+}
+
+var _ validator.Object = attributesOrderValidator{}
+
+// attributesOrderValidator validates that the numeric attribute of an object does not exceed the other one.
+type attributesOrderValidator struct {
+	lower  string
+	upper  string
+	strict bool
+}
+
+func (v attributesOrderValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v attributesOrderValidator) MarkdownDescription(_ context.Context) string {
+	if v.strict {
+		return fmt.Sprintf("%s must be less than %s", v.lower, v.upper)
+	}
+
+	return fmt.Sprintf("%s must be less than or equal to %s", v.lower, v.upper)
+}
+
+func (v attributesOrderValidator) ValidateObject(ctx context.Context, request validator.ObjectRequest, response *validator.ObjectResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := request.ConfigValue.Attributes()
+
+	lower, ok := numberValue(attributes[v.lower])
+	if !ok {
+		return
+	}
+
+	upper, ok := numberValue(attributes[v.upper])
+	if !ok {
+		return
+	}
+
+	if lower > upper || (v.strict && lower == upper) {
+		response.Diagnostics.AddAttributeError(
+			request.Path.AtName(v.upper),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Attribute %s, got: %s = %v, %s = %v", v.Description(ctx), v.lower, lower, v.upper, upper),
+		)
+	}
+}
+
+// numberValue returns the value of a known numeric attribute.
+func numberValue(value attr.Value) (float64, bool) {
+	switch number := value.(type) {
+	case types.Float64:
+		if number.IsNull() || number.IsUnknown() {
+			return 0, false
+		}
+
+		return number.ValueFloat64(), true
+	case types.Int64:
+		if number.IsNull() || number.IsUnknown() {
+			return 0, false
+		}
+
+		return float64(number.ValueInt64()), true
+	default:
+		return 0, false
+	}
+}
+
+// lessThan checks that the value of the lower attribute is less than the value of the upper one, when both are set.
+func lessThan(lower, upper string) validator.Object {
+	return attributesOrderValidator{lower: lower, upper: upper, strict: true}
+}
+
+// lessThanOrEqual checks that the value of the lower attribute does not exceed the value of the upper one, when both are set.
+func lessThanOrEqual(lower, upper string) validator.Object {
+	return attributesOrderValidator{lower: lower, upper: upper}
+}
+
+var _ validator.Object = exactlyOneBlockValidator{}
+
+// exactlyOneBlockValidator validates that exactly one of the nested blocks of an object is defined.
+type exactlyOneBlockValidator struct {
+	blocks []string
+}
+
+func (v exactlyOneBlockValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v exactlyOneBlockValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("exactly one of the blocks must be defined: %s", strings.Join(v.blocks, ", "))
+}
+
+func (v exactlyOneBlockValidator) ValidateObject(ctx context.Context, request validator.ObjectRequest, response *validator.ObjectResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attributes := request.ConfigValue.Attributes()
+	var defined []string
+
+	for _, name := range v.blocks {
+		switch block := attributes[name].(type) {
+		case types.List:
+			if block.IsUnknown() {
+				return
+			}
+
+			if !block.IsNull() && len(block.Elements()) > 0 {
+				defined = append(defined, name)
+			}
+		}
+	}
+
+	if len(defined) != 1 {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Block Combination",
+			fmt.Sprintf("The %s, got: %d (%s)", v.Description(ctx), len(defined), strings.Join(defined, ", ")),
+		)
+	}
+}
+
+// exactlyOneBlock checks that exactly one of the nested blocks is defined.
+func exactlyOneBlock(blocks ...string) validator.Object {
+	return exactlyOneBlockValidator{blocks: blocks}
+}
+
+type queryRefID struct {
+	path  path.Path
+	value types.String
+}
+
+// queryRefIDs returns the ref_id attributes of the queries in the same order as createTargets emits the targets.
+func queryRefIDs(queries []Query) []queryRefID {
+	refIDs := make([]queryRefID, 0)
+
+	for i, group := range queries {
+		groupPath := path.Root("queries").AtListIndex(i)
+
+		for j, target := range group.Prometheus {
+			refIDs = append(refIDs, queryRefID{groupPath.AtName("prometheus").AtListIndex(j).AtName("ref_id"), target.RefId})
+		}
+
+		for j, target := range group.CloudWatch {
+			refIDs = append(refIDs, queryRefID{groupPath.AtName("cloudwatch").AtListIndex(j).AtName("ref_id"), target.RefId})
+		}
+
+		for j, target := range group.Raw {
+			refIDs = append(refIDs, queryRefID{groupPath.AtName("raw").AtListIndex(j).AtName("ref_id"), target.RefId})
+		}
+	}
+
+	return refIDs
+}
+
+// validatePanelConfig checks the queries and overrides of a panel against each other.
+// Unknown blocks cannot be read into the model, hence the data sources skip the validation
+// when the configuration cannot be read, and such configuration is validated once the values are known.
+func validatePanelConfig[F any](queries []Query, overrides []FieldOverrideOptions[F], createProperties func([]F) []grafana.FieldOverrideProperty, diags *diag.Diagnostics) {
+	validateQueryRefIDs(queries, overrides, diags)
+	validateOverrideProperties(overrides, createProperties, diags)
+}
+
+// validateQueryRefIDs checks that the queries of a panel have unique ref IDs
+// and that the overrides reference the existing queries only.
+func validateQueryRefIDs[F any](queries []Query, overrides []FieldOverrideOptions[F], diags *diag.Diagnostics) {
+	defined := make(map[string]path.Path)
+	refIDs := queryRefIDs(queries)
+	ids := make([]string, len(refIDs))
+	known := true
+
+	for i, refID := range refIDs {
+		if refID.value.IsUnknown() {
+			known = false
+			continue
+		}
+
+		id := refID.value.ValueString()
+		ids[i] = id
+		if id == "" {
+			continue
+		}
+
+		if first, ok := defined[id]; ok {
+			diags.AddAttributeError(
+				refID.path,
+				"Duplicate Query Ref ID",
+				fmt.Sprintf("The ref ID %q is already used by the query at %s. The ref IDs must be unique within a panel.", id, first),
+			)
+			continue
+		}
+
+		defined[id] = refID.path
+	}
+
+	// The ref IDs of the remaining queries cannot be determined until all values are known
+	if !known {
+		return
+	}
+
+	fillRefIDs(ids)
+
+	exists := make(map[string]bool)
+
+	for _, id := range ids {
+		exists[id] = true
+	}
+
+	for i, override := range overrides {
+		for j, byQueryID := range override.ByQueryID {
+			if byQueryID.QueryID.IsNull() || byQueryID.QueryID.IsUnknown() || exists[byQueryID.QueryID.ValueString()] {
+				continue
+			}
+
+			diags.AddAttributeError(
+				path.Root("overrides").AtListIndex(i).AtName("by_query_id").AtListIndex(j).AtName("query_id"),
+				"Unknown Query Ref ID",
+				fmt.Sprintf("The query ID %q does not match any query of the panel. Available ref IDs: [%s].", byQueryID.QueryID.ValueString(), strings.Join(ids, ", ")),
+			)
+		}
+	}
+}
+
+// validateOverrideProperties checks that every override of a panel defines at least one property,
+// since an override without properties has no effect.
+func validateOverrideProperties[F any](overrides []FieldOverrideOptions[F], createProperties func([]F) []grafana.FieldOverrideProperty, diags *diag.Diagnostics) {
+	validate := func(matcherPath path.Path, fields []F) {
+		if len(createProperties(fields)) > 0 {
+			return
+		}
+
+		diags.AddAttributeError(
+			matcherPath.AtName("field"),
+			"Missing Override Properties",
+			fmt.Sprintf("The override at %s does not define any property. Set at least one attribute or block of the field block.", matcherPath),
+		)
+	}
+
+	for i, override := range overrides {
+		overridePath := path.Root("overrides").AtListIndex(i)
+
+		for j, byName := range override.ByName {
+			validate(overridePath.AtName("by_name").AtListIndex(j), byName.Field)
+		}
+
+		for j, byRegex := range override.ByRegex {
+			validate(overridePath.AtName("by_regex").AtListIndex(j), byRegex.Field)
+		}
+
+		for j, byType := range override.ByType {
+			validate(overridePath.AtName("by_type").AtListIndex(j), byType.Field)
+		}
+
+		for j, byQueryID := range override.ByQueryID {
+			validate(overridePath.AtName("by_query_id").AtListIndex(j), byQueryID.Field)
+		}
+
+		for j, byNames := range override.ByNames {
+			validate(overridePath.AtName("by_names").AtListIndex(j), byNames.Field)
+		}
+
+		for j, byValue := range override.ByValue {
+			validate(overridePath.AtName("by_value").AtListIndex(j), byValue.Field)
+		}
+	}
+}
+
+// closestMatches returns up to 3 candidates that are the closest to the given value, to be suggested on typos.
+func closestMatches(value string, candidates []string) []string {
+	type match struct {
+		value    string
+		distance int
+	}
+
+	normalized := strings.ToLower(strings.TrimSpace(value))
+
+	// the shorter the value, the fewer typos are tolerated
+	maxDistance := 1
+	if len(normalized) > 9 {
+		maxDistance = 3
+	} else if len(normalized) > 3 {
+		maxDistance = 2
+	}
+
+	matches := make([]match, 0)
+
+	for _, candidate := range candidates {
+		distance := levenshtein(normalized, strings.ToLower(candidate))
+		if distance <= maxDistance {
+			matches = append(matches, match{candidate, distance})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].distance < matches[j].distance
+	})
+
+	closest := make([]string, 0)
+
+	// only the closest matches are suggested
+	for i := 0; i < len(matches) && i < 3 && matches[i].distance == matches[0].distance; i++ {
+		closest = append(closest, matches[i].value)
+	}
+
+	return closest
+}
+
+func levenshtein(a, b string) int {
+	source := []rune(a)
+	target := []rune(b)
+
+	previous := make([]int, len(target)+1)
+	current := make([]int, len(target)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(source); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(target); j++ {
+			cost := 1
+			if source[i-1] == target[j-1] {
+				cost = 0
+			}
+
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(target)]
+}
+
+func minInt(values ...int) int {
+	result := values[0]
+
+	for _, value := range values[1:] {
+		if value < result {
+			result = value
+		}
+	}
+
+	return result
+}
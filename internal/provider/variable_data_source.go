@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces
+var _ datasource.DataSource = &VariableDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &VariableDataSource{}
+
+func NewVariableDataSource() datasource.DataSource {
+	return &VariableDataSource{}
+}
+
+// VariableDataSource defines the data source implementation.
+type VariableDataSource struct {
+}
+
+// VariableDataSourceModel describes the data source data model.
+type VariableDataSourceModel struct {
+	Id         types.String         `tfsdk:"id"`
+	Json       types.String         `tfsdk:"json"`
+	Custom     []VariableCustom     `tfsdk:"custom"`
+	Constant   []VariableConstant   `tfsdk:"const"`
+	Query      []VariableQuery      `tfsdk:"query"`
+	Datasource []VariableDatasource `tfsdk:"datasource"`
+	Interval   []VariableInterval   `tfsdk:"interval"`
+	Textbox    []VariableTextbox    `tfsdk:"textbox"`
+	AdHoc      []VariableAdHoc      `tfsdk:"adhoc"`
+}
+
+func (m VariableDataSourceModel) variable() Variable {
+	return Variable{
+		Custom:     m.Custom,
+		Constant:   m.Constant,
+		Query:      m.Query,
+		Datasource: m.Datasource,
+		Interval:   m.Interval,
+		Textbox:    m.Textbox,
+		AdHoc:      m.AdHoc,
+	}
+}
+
+func (d *VariableDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_variable"
+}
+
+func (d *VariableDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Variable data source. Exactly one variable must be defined. The JSON can be reused by several dashboards.",
+		MarkdownDescription: "Variable data source. Exactly one variable must be defined. The JSON can be reused by several dashboards " +
+			"via the `source` of the `variables` block. See Grafana [documentation](https://grafana.com/docs/grafana/latest/dashboards/variables/) for more details.",
+
+		Blocks: variableBlocks(),
+
+		Attributes: map[string]schema.Attribute{
+			"id": idAttribute(),
+			"json": schema.StringAttribute{
+				Computed:    true,
+				Description: "The Grafana-API-compatible JSON of this variable.",
+			},
+		},
+	}
+}
+
+func (d *VariableDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+}
+
+func (d *VariableDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data VariableDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	if diags.HasError() {
+		return
+	}
+
+	count := len(data.Custom) + len(data.Constant) + len(data.Query) + len(data.Datasource) +
+		len(data.Interval) + len(data.Textbox) + len(data.AdHoc)
+
+	if count != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid Variable Definition",
+			fmt.Sprintf("Exactly one variable must be defined, got: %d.", count),
+		)
+	}
+}
+
+func (d *VariableDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data VariableDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vars := createTemplateVars(data.variable())
+
+	if len(vars) != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid Variable Definition",
+			fmt.Sprintf("Exactly one variable must be defined, got: %d.", len(vars)),
+		)
+		return
+	}
+
+	jsonData, err := json.MarshalIndent(vars[0], "", "  ")
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Could not marshal json: %s", err))
+		return
+	}
+
+	data.Json = types.StringValue(string(jsonData))
+	data.Id = types.StringValue(strconv.Itoa(hashcode(jsonData)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccVariableDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccVariableDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.gdashboard_variable.test", "json", testAccVariableDataSourceConfigExpectedJson),
+				),
+			},
+			{
+				Config:      testAccVariableDataSourceMultipleVariablesConfig,
+				ExpectError: regexp.MustCompile("Exactly one variable must be defined, got: 2"),
+			},
+			{
+				Config:      testAccVariableDataSourceInvalidIntervalConfig,
+				ExpectError: regexp.MustCompile("must be one of the intervals or auto when auto is enabled"),
+			},
+		},
+	})
+}
+
+const testAccVariableDataSourceConfig = `
+data "gdashboard_variable" "test" {
+  query {
+    name           = "namespace"
+    datasource_uid = "prometheus"
+    query          = "label_values(kube_pod_info{cluster=\"$cluster\"}, namespace)"
+    sort           = "alphabetical-asc"
+    refresh        = "on-time-range-change"
+    include_all    = true
+  }
+}
+`
+
+const testAccVariableDataSourceConfigExpectedJson = `{
+  "name": "namespace",
+  "type": "query",
+  "datasource": {
+    "id": 0,
+    "orgId": 0,
+    "uid": "prometheus",
+    "name": "",
+    "type": "prometheus",
+    "typeLogoUrl": "",
+    "access": "",
+    "url": "",
+    "isDefault": false,
+    "jsonData": null,
+    "secureJsonData": null
+  },
+  "refresh": 2,
+  "options": null,
+  "includeAll": true,
+  "allFormat": "",
+  "allValue": "",
+  "multi": false,
+  "multiFormat": "",
+  "query": "label_values(kube_pod_info{cluster=\"$cluster\"}, namespace)",
+  "regex": "",
+  "current": {
+    "text": null,
+    "value": null
+  },
+  "label": "",
+  "hide": 0,
+  "sort": 1
+}`
+
+const testAccVariableDataSourceMultipleVariablesConfig = `
+data "gdashboard_variable" "test" {
+  const {
+    name  = "cluster"
+    value = "eu-west-1"
+  }
+
+  textbox {
+    name = "tenant"
+  }
+}
+`
+
+const testAccVariableDataSourceInvalidIntervalConfig = `
+data "gdashboard_variable" "test" {
+  interval {
+    name      = "step"
+    intervals = ["1m", "10m"]
+    auto      = true
+    current   = "5m"
+  }
+}
+`